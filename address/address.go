@@ -0,0 +1,400 @@
+// Package address parses and validates Cardano addresses: Shelley base,
+// pointer, enterprise and reward addresses in bech32, and Byron addresses in
+// base58.
+package address
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/ripoff2/tangocrypto-go/internal/bech32"
+	"github.com/ripoff2/tangocrypto-go/internal/cbor"
+)
+
+var (
+	// ErrInvalidAddress is wrapped by every parsing error.
+	ErrInvalidAddress = errors.New("address: invalid address")
+	// ErrNoStakeCredential is returned by StakeAddress when the stake
+	// credential of an address is not known locally.
+	ErrNoStakeCredential = errors.New("address: no stake credential")
+)
+
+// Network identifies the network an address belongs to.
+type Network byte
+
+const (
+	Testnet Network = 0
+	Mainnet Network = 1
+)
+
+func (n Network) String() string {
+	if n == Mainnet {
+		return "mainnet"
+	}
+	return "testnet"
+}
+
+// Type is the kind of an address.
+type Type byte
+
+const (
+	Base Type = iota
+	Pointer
+	Enterprise
+	Reward
+	Byron
+)
+
+func (t Type) String() string {
+	switch t {
+	case Base:
+		return "base"
+	case Pointer:
+		return "pointer"
+	case Enterprise:
+		return "enterprise"
+	case Reward:
+		return "reward"
+	case Byron:
+		return "byron"
+	}
+	return fmt.Sprintf("Type(%d)", byte(t))
+}
+
+// CredentialKind tells whether a credential is a key hash or a script hash.
+type CredentialKind byte
+
+const (
+	KeyHash CredentialKind = iota
+	ScriptHash
+)
+
+// Credential is a payment or stake credential, a blake2b-224 hash.
+type Credential struct {
+	Kind CredentialKind
+	Hash []byte
+}
+
+// HashHex returns the hex encoded hash of the credential.
+func (c Credential) HashHex() string {
+	return hex.EncodeToString(c.Hash)
+}
+
+// ChainPointer locates the stake registration certificate referenced by a
+// pointer address.
+type ChainPointer struct {
+	Slot      uint64
+	TxIndex   uint64
+	CertIndex uint64
+}
+
+// Address is a decoded Cardano address.
+type Address struct {
+	Type    Type
+	Network Network
+
+	// Payment is the payment credential, nil for reward addresses. For
+	// Byron addresses it holds the address root.
+	Payment *Credential
+	// Stake is the stake credential of base and reward addresses.
+	Stake *Credential
+	// Pointer is set for pointer addresses.
+	Pointer *ChainPointer
+
+	raw  []byte
+	text string
+}
+
+// Parse decodes a bech32 Shelley address or a base58 Byron address.
+func Parse(s string) (Address, error) {
+	if s == "" {
+		return Address{}, fmt.Errorf("%w: empty string", ErrInvalidAddress)
+	}
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "addr") || strings.HasPrefix(lower, "stake") {
+		return parseBech32(s)
+	}
+	return parseByron(s)
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(s string) Address {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Valid reports whether s is a valid address.
+func Valid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+func parseBech32(s string) (Address, error) {
+	hrp, raw, err := bech32.Decode(s)
+	if err != nil {
+		return Address{}, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if len(raw) == 0 {
+		return Address{}, fmt.Errorf("%w: empty payload", ErrInvalidAddress)
+	}
+
+	a, err := fromBytes(raw)
+	if err != nil {
+		return Address{}, err
+	}
+	if a.Type == Byron {
+		return Address{}, fmt.Errorf("%w: byron address in bech32", ErrInvalidAddress)
+	}
+	if expected := a.hrp(); hrp != expected {
+		return Address{}, fmt.Errorf("%w: prefix %q does not match %s %s address", ErrInvalidAddress, hrp, a.Network, a.Type)
+	}
+
+	a.text = strings.ToLower(s)
+	return a, nil
+}
+
+// FromBytes decodes an address from its binary form.
+func FromBytes(raw []byte) (Address, error) {
+	a, err := fromBytes(raw)
+	if err != nil {
+		return Address{}, err
+	}
+	if a.Type == Byron {
+		a.text = base58Encode(raw)
+	} else {
+		a.text, _ = bech32.Encode(a.hrp(), raw)
+	}
+	return a, nil
+}
+
+func fromBytes(raw []byte) (Address, error) {
+	if len(raw) == 0 {
+		return Address{}, fmt.Errorf("%w: empty payload", ErrInvalidAddress)
+	}
+
+	header, payload := raw[0], raw[1:]
+	kind, network := header>>4, Network(header&0x0f)
+	if kind != 8 && network > Mainnet {
+		return Address{}, fmt.Errorf("%w: unknown network id %d", ErrInvalidAddress, network)
+	}
+
+	credential := func(script bool, hash []byte) *Credential {
+		c := &Credential{Kind: KeyHash, Hash: append([]byte(nil), hash...)}
+		if script {
+			c.Kind = ScriptHash
+		}
+		return c
+	}
+	length := func(n int) error {
+		if len(payload) != n {
+			return fmt.Errorf("%w: %d bytes payload, expected %d", ErrInvalidAddress, len(payload), n)
+		}
+		return nil
+	}
+
+	a := Address{Network: network, raw: append([]byte(nil), raw...)}
+	switch kind {
+	case 0, 1, 2, 3:
+		if err := length(56); err != nil {
+			return Address{}, err
+		}
+		a.Type = Base
+		a.Payment = credential(kind&1 != 0, payload[:28])
+		a.Stake = credential(kind&2 != 0, payload[28:])
+	case 4, 5:
+		if len(payload) < 28+3 {
+			return Address{}, fmt.Errorf("%w: pointer address too short", ErrInvalidAddress)
+		}
+		ptr, err := decodePointer(payload[28:])
+		if err != nil {
+			return Address{}, err
+		}
+		a.Type = Pointer
+		a.Payment = credential(kind == 5, payload[:28])
+		a.Pointer = &ptr
+	case 6, 7:
+		if err := length(28); err != nil {
+			return Address{}, err
+		}
+		a.Type = Enterprise
+		a.Payment = credential(kind == 7, payload)
+	case 14, 15:
+		if err := length(28); err != nil {
+			return Address{}, err
+		}
+		a.Type = Reward
+		a.Stake = credential(kind == 15, payload)
+	case 8:
+		return decodeByron(raw)
+	default:
+		return Address{}, fmt.Errorf("%w: unknown address type %d", ErrInvalidAddress, kind)
+	}
+
+	return a, nil
+}
+
+// decodePointer decodes the three variable length naturals of a pointer.
+func decodePointer(data []byte) (ChainPointer, error) {
+	var values [3]uint64
+	for i := range values {
+		var v uint64
+		for {
+			if len(data) == 0 {
+				return ChainPointer{}, fmt.Errorf("%w: truncated pointer", ErrInvalidAddress)
+			}
+			if v > (1<<64-1)>>7 {
+				return ChainPointer{}, fmt.Errorf("%w: pointer overflow", ErrInvalidAddress)
+			}
+			b := data[0]
+			data = data[1:]
+			v = v<<7 | uint64(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		values[i] = v
+	}
+	if len(data) != 0 {
+		return ChainPointer{}, fmt.Errorf("%w: trailing bytes after pointer", ErrInvalidAddress)
+	}
+	return ChainPointer{Slot: values[0], TxIndex: values[1], CertIndex: values[2]}, nil
+}
+
+// mainnetProtocolMagic is the protocol magic of the Cardano mainnet.
+const mainnetProtocolMagic = 764824073
+
+func parseByron(s string) (Address, error) {
+	raw, err := base58Decode(s)
+	if err != nil {
+		return Address{}, err
+	}
+	a, err := decodeByron(raw)
+	if err != nil {
+		return Address{}, err
+	}
+	a.text = s
+	return a, nil
+}
+
+// decodeByron decodes [tag 24 (payload), crc32 (payload)] where payload is
+// [root, attributes, type].
+func decodeByron(raw []byte) (Address, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: byron address: %s", ErrInvalidAddress, reason)
+	}
+
+	v, err := cbor.Unmarshal(raw)
+	if err != nil {
+		return Address{}, invalid(err.Error())
+	}
+	outer, ok := v.([]interface{})
+	if !ok || len(outer) != 2 {
+		return Address{}, invalid("not a [payload, crc] array")
+	}
+	tag, ok := outer[0].(cbor.Tag)
+	if !ok || tag.Number != 24 {
+		return Address{}, invalid("payload is not tagged CBOR")
+	}
+	payload, ok := tag.Content.([]byte)
+	if !ok {
+		return Address{}, invalid("payload is not a byte string")
+	}
+	crc, ok := outer[1].(uint64)
+	if !ok || uint64(crc32.ChecksumIEEE(payload)) != crc {
+		return Address{}, invalid("checksum mismatch")
+	}
+
+	v, err = cbor.Unmarshal(payload)
+	if err != nil {
+		return Address{}, invalid(err.Error())
+	}
+	inner, ok := v.([]interface{})
+	if !ok || len(inner) != 3 {
+		return Address{}, invalid("payload is not a [root, attributes, type] array")
+	}
+	root, ok := inner[0].([]byte)
+	if !ok || len(root) != 28 {
+		return Address{}, invalid("invalid address root")
+	}
+	attrs, ok := inner[1].(cbor.Map)
+	if !ok {
+		return Address{}, invalid("invalid attributes")
+	}
+
+	network := Mainnet
+	for _, p := range attrs {
+		if k, ok := p.Key.(uint64); !ok || k != 2 {
+			continue
+		}
+		b, ok := p.Value.([]byte)
+		if !ok {
+			return Address{}, invalid("invalid protocol magic")
+		}
+		magic, err := cbor.Unmarshal(b)
+		if m, ok := magic.(uint64); err != nil || !ok {
+			return Address{}, invalid("invalid protocol magic")
+		} else if m != mainnetProtocolMagic {
+			network = Testnet
+		}
+	}
+
+	return Address{
+		Type:    Byron,
+		Network: network,
+		Payment: &Credential{Kind: KeyHash, Hash: append([]byte(nil), root...)},
+		raw:     append([]byte(nil), raw...),
+	}, nil
+}
+
+func (a Address) hrp() string {
+	prefix := "addr"
+	if a.Type == Reward {
+		prefix = "stake"
+	}
+	if a.Network != Mainnet {
+		prefix += "_test"
+	}
+	return prefix
+}
+
+// String returns the address in its canonical text form.
+func (a Address) String() string {
+	return a.text
+}
+
+// Bytes returns the binary form of the address.
+func (a Address) Bytes() []byte {
+	return append([]byte(nil), a.raw...)
+}
+
+// Equal reports whether a and b are the same address.
+func (a Address) Equal(b Address) bool {
+	return bytes.Equal(a.raw, b.raw)
+}
+
+// StakeAddress returns the reward address holding the stake credential of a,
+// or a itself for reward addresses. It returns ErrNoStakeCredential for
+// enterprise and Byron addresses, and for pointer addresses whose stake
+// credential can only be resolved on chain.
+func (a Address) StakeAddress() (Address, error) {
+	if a.Type == Reward {
+		return a, nil
+	}
+	if a.Stake == nil {
+		return Address{}, fmt.Errorf("%w: %s address", ErrNoStakeCredential, a.Type)
+	}
+
+	header := byte(0xe0)
+	if a.Stake.Kind == ScriptHash {
+		header = 0xf0
+	}
+	raw := append([]byte{header | byte(a.Network)}, a.Stake.Hash...)
+	return FromBytes(raw)
+}
@@ -0,0 +1,245 @@
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ripoff2/tangocrypto-go/internal/bech32"
+)
+
+// CIP-19 test vectors.
+const (
+	paymentKeyHash = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	stakeKeyHash   = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	scriptHash     = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+
+	byronMainnet = "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi"
+	byronTestnet = "37btjrVyb4KEB2STADSsj3MYSAdj52X5FrFWpw2r7Wmj2GDzXjFRsHWuZqrw7zSkwopv8Ci3VWeg6bisU9dgJxW5hb2MZYeduNKbQJrqz3zVBsu9nT"
+)
+
+func TestParse(t *testing.T) {
+	key := func(h string) *Credential { return &Credential{Kind: KeyHash, Hash: mustHex(h)} }
+	script := func(h string) *Credential { return &Credential{Kind: ScriptHash, Hash: mustHex(h)} }
+
+	tests := []struct {
+		addr    string
+		typ     Type
+		network Network
+		payment *Credential
+		stake   *Credential
+		pointer *ChainPointer
+	}{
+		{"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			Base, Mainnet, key(paymentKeyHash), key(stakeKeyHash), nil},
+		{"addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+			Base, Mainnet, script(scriptHash), key(stakeKeyHash), nil},
+		{"addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve",
+			Base, Mainnet, key(paymentKeyHash), script(scriptHash), nil},
+		{"addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g",
+			Base, Mainnet, script(scriptHash), script(scriptHash), nil},
+		{"addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k",
+			Pointer, Mainnet, key(paymentKeyHash), nil, &ChainPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}},
+		{"addr128phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtupnz75xxcrtw79hu",
+			Pointer, Mainnet, script(scriptHash), nil, &ChainPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}},
+		{"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+			Enterprise, Mainnet, key(paymentKeyHash), nil, nil},
+		{"addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+			Enterprise, Mainnet, script(scriptHash), nil, nil},
+		{"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+			Reward, Mainnet, nil, key(stakeKeyHash), nil},
+		{"stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5",
+			Reward, Mainnet, nil, script(scriptHash), nil},
+		{"addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+			Base, Testnet, key(paymentKeyHash), key(stakeKeyHash), nil},
+		{"addr_test1gz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrdw5vky",
+			Pointer, Testnet, key(paymentKeyHash), nil, &ChainPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}},
+		{"addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+			Enterprise, Testnet, key(paymentKeyHash), nil, nil},
+		{"stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+			Reward, Testnet, nil, key(stakeKeyHash), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			a, err := Parse(tt.addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a.Type != tt.typ || a.Network != tt.network {
+				t.Errorf("got %s %s, want %s %s", a.Network, a.Type, tt.network, tt.typ)
+			}
+			if !equalCredential(a.Payment, tt.payment) {
+				t.Errorf("payment = %+v, want %+v", a.Payment, tt.payment)
+			}
+			if !equalCredential(a.Stake, tt.stake) {
+				t.Errorf("stake = %+v, want %+v", a.Stake, tt.stake)
+			}
+			if (a.Pointer == nil) != (tt.pointer == nil) || (a.Pointer != nil && *a.Pointer != *tt.pointer) {
+				t.Errorf("pointer = %+v, want %+v", a.Pointer, tt.pointer)
+			}
+			if a.String() != tt.addr {
+				t.Errorf("String() = %s", a)
+			}
+
+			b, err := FromBytes(a.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != tt.addr || !b.Equal(a) {
+				t.Errorf("FromBytes(Bytes()) = %s", b)
+			}
+
+			upper, err := Parse(strings.ToUpper(tt.addr))
+			if err != nil || upper.String() != tt.addr {
+				t.Errorf("Parse(upper case) = %s, %v", upper, err)
+			}
+		})
+	}
+}
+
+func TestParseByron(t *testing.T) {
+	tests := []struct {
+		addr    string
+		network Network
+	}{
+		{byronMainnet, Mainnet},
+		{byronTestnet, Testnet},
+	}
+	for _, tt := range tests {
+		a, err := Parse(tt.addr)
+		if err != nil {
+			t.Fatalf("Parse(%s): %v", tt.addr, err)
+		}
+		if a.Type != Byron || a.Network != tt.network || a.Payment == nil || len(a.Payment.Hash) != 28 {
+			t.Errorf("Parse(%s) = %s %s, root %+v", tt.addr, a.Network, a.Type, a.Payment)
+		}
+		if a.String() != tt.addr {
+			t.Errorf("String() = %s", a)
+		}
+		b, err := FromBytes(a.Bytes())
+		if err != nil || b.String() != tt.addr {
+			t.Errorf("FromBytes(Bytes()) = %s, %v", b, err)
+		}
+		if _, err := a.StakeAddress(); !errors.Is(err, ErrNoStakeCredential) {
+			t.Errorf("StakeAddress = %v, want ErrNoStakeCredential", err)
+		}
+	}
+}
+
+func TestStakeAddress(t *testing.T) {
+	tests := []struct {
+		addr, stake string
+	}{
+		{"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+			"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve",
+			"stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5"},
+		{"addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+			"stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn"},
+		{"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+			"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+	}
+	for _, tt := range tests {
+		s, err := MustParse(tt.addr).StakeAddress()
+		if err != nil {
+			t.Fatalf("StakeAddress(%s): %v", tt.addr, err)
+		}
+		if s.String() != tt.stake || s.Type != Reward {
+			t.Errorf("StakeAddress(%s) = %s, want %s", tt.addr, s, tt.stake)
+		}
+	}
+
+	for _, addr := range []string{
+		"addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k",
+		"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+	} {
+		if _, err := MustParse(addr).StakeAddress(); !errors.Is(err, ErrNoStakeCredential) {
+			t.Errorf("StakeAddress(%s) = %v, want ErrNoStakeCredential", addr, err)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	enterprise := MustParse("addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8").Bytes()
+	reward := MustParse("stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw").Bytes()
+	base := MustParse("addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x").Bytes()
+
+	encode := func(hrp string, raw []byte) string {
+		s, err := bech32.Encode(hrp, raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	withHeader := func(raw []byte, header byte) []byte {
+		return append([]byte{header}, raw[1:]...)
+	}
+
+	byron := MustParse(byronMainnet).Bytes()
+	badRoot := append([]byte(nil), byron...)
+	badRoot[12] ^= 0xff
+	badCRC := append([]byte(nil), byron...)
+	badCRC[len(badCRC)-1] ^= 0xff
+
+	tests := []struct {
+		name string
+		addr string
+	}{
+		{"empty", ""},
+		{"checksum", "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9"},
+		{"enterprise with stake prefix", encode("stake", enterprise)},
+		{"reward with addr prefix", encode("addr", reward)},
+		{"mainnet with testnet prefix", encode("addr_test", enterprise)},
+		{"testnet with mainnet prefix", encode("addr", withHeader(enterprise, 0x60))},
+		{"unknown network", encode("addr", withHeader(enterprise, 0x62))},
+		{"unknown type", encode("addr", withHeader(enterprise, 0x91))},
+		{"base too short", encode("addr", base[:len(base)-1])},
+		{"base too long", encode("addr", append(base, 0))},
+		{"enterprise too long", encode("addr", append(enterprise, 0))},
+		{"reward too short", encode("stake", reward[:len(reward)-1])},
+		{"truncated pointer", encode("addr", withHeader(enterprise, 0x41))},
+		{"pointer trailing bytes", encode("addr", append(MustParse("addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k").Bytes(), 0))},
+		{"byron in bech32", encode("addr", byron)},
+		{"byron root changed", base58Encode(badRoot)},
+		{"byron crc changed", base58Encode(badCRC)},
+		{"byron invalid base58", "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMA0"},
+		{"byron not cbor", base58Encode([]byte("not an address"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Parse(tt.addr)
+			if !errors.Is(err, ErrInvalidAddress) {
+				t.Fatalf("Parse(%s) = %s %s, %v, want ErrInvalidAddress", tt.addr, a.Network, a.Type, err)
+			}
+			if Valid(tt.addr) {
+				t.Fatalf("Valid(%s) = true", tt.addr)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse did not panic")
+		}
+	}()
+	MustParse("addr1")
+}
+
+func equalCredential(a, b *Credential) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Kind == b.Kind && a.HashHex() == b.HashHex()
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
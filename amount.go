@@ -0,0 +1,241 @@
+package tangocrypto_go
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// LovelacePerADA is the number of lovelace in one ADA.
+const LovelacePerADA = 1_000_000
+
+var (
+	// ErrOverflow is returned when an amount does not fit its type.
+	ErrOverflow = errors.New("tangocrypto: amount overflow")
+	// ErrNegativeAmount is returned when an unsigned amount would become
+	// negative.
+	ErrNegativeAmount = errors.New("tangocrypto: negative amount")
+)
+
+// Lovelace is an amount of ADA expressed in lovelace. It decodes from both
+// JSON numbers and numeric strings and encodes as a JSON number.
+type Lovelace uint64
+
+// ParseLovelace parses a decimal amount of lovelace.
+func ParseLovelace(s string) (Lovelace, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("lovelace: %w", err)
+	}
+	return Lovelace(v), nil
+}
+
+// ParseADA parses an amount of ADA with at most 6 decimals, such as "12.5".
+func ParseADA(s string) (Lovelace, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || len(frac) > 6 || strings.HasPrefix(frac, "+") || strings.HasPrefix(frac, "-") {
+		return 0, fmt.Errorf("ada: invalid amount %q", s)
+	}
+
+	var w, f uint64
+	var err error
+	if whole != "" {
+		if w, err = strconv.ParseUint(whole, 10, 64); err != nil {
+			return 0, fmt.Errorf("ada: invalid amount %q", s)
+		}
+	}
+	if frac != "" {
+		if f, err = strconv.ParseUint(frac+strings.Repeat("0", 6-len(frac)), 10, 64); err != nil {
+			return 0, fmt.Errorf("ada: invalid amount %q", s)
+		}
+	}
+
+	if w > (math.MaxUint64-f)/LovelacePerADA {
+		return 0, ErrOverflow
+	}
+	return Lovelace(w*LovelacePerADA + f), nil
+}
+
+// Add returns l+o, or ErrOverflow.
+func (l Lovelace) Add(o Lovelace) (Lovelace, error) {
+	if l > math.MaxUint64-o {
+		return 0, ErrOverflow
+	}
+	return l + o, nil
+}
+
+// Sub returns l-o, or ErrNegativeAmount when o is greater than l.
+func (l Lovelace) Sub(o Lovelace) (Lovelace, error) {
+	if o > l {
+		return 0, ErrNegativeAmount
+	}
+	return l - o, nil
+}
+
+// Mul returns l*n, or ErrOverflow.
+func (l Lovelace) Mul(n uint64) (Lovelace, error) {
+	if n != 0 && uint64(l) > math.MaxUint64/n {
+		return 0, ErrOverflow
+	}
+	return l * Lovelace(n), nil
+}
+
+// Cmp compares l and o and returns -1, 0 or +1.
+func (l Lovelace) Cmp(o Lovelace) int {
+	switch {
+	case l < o:
+		return -1
+	case l > o:
+		return 1
+	}
+	return 0
+}
+
+// ADA formats l in ADA with 6 decimals, e.g. "12.500000".
+func (l Lovelace) ADA() string {
+	return fmt.Sprintf("%d.%06d", l/LovelacePerADA, l%LovelacePerADA)
+}
+
+// String formats l in lovelace.
+func (l Lovelace) String() string {
+	return strconv.FormatUint(uint64(l), 10)
+}
+
+func (l *Lovelace) UnmarshalJSON(data []byte) error {
+	s, ok, err := numericJSON(data)
+	if err != nil || !ok {
+		*l = 0
+		return err
+	}
+
+	v, err := ParseLovelace(s)
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
+
+// Quantity is an arbitrary precision amount of a native asset. Token
+// quantities go up to 2^64-1 and mints can be negative, which neither int
+// nor uint64 can represent. The zero value is 0. Quantity values are
+// immutable and safe to copy.
+type Quantity struct {
+	i *big.Int
+}
+
+// NewQuantity returns a Quantity set to v.
+func NewQuantity(v int64) Quantity {
+	return Quantity{big.NewInt(v)}
+}
+
+// QuantityFromUint64 returns a Quantity set to v.
+func QuantityFromUint64(v uint64) Quantity {
+	return Quantity{new(big.Int).SetUint64(v)}
+}
+
+// QuantityFromBig returns a Quantity set to a copy of v.
+func QuantityFromBig(v *big.Int) Quantity {
+	if v == nil {
+		return Quantity{}
+	}
+	return Quantity{new(big.Int).Set(v)}
+}
+
+// ParseQuantity parses a decimal, possibly negative, quantity.
+func ParseQuantity(s string) (Quantity, error) {
+	if strings.HasPrefix(s, "+") {
+		return Quantity{}, fmt.Errorf("quantity: invalid value %q", s)
+	}
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Quantity{}, fmt.Errorf("quantity: invalid value %q", s)
+	}
+	return Quantity{i}, nil
+}
+
+func (q Quantity) big() *big.Int {
+	if q.i == nil {
+		return new(big.Int)
+	}
+	return q.i
+}
+
+// Add returns q+o.
+func (q Quantity) Add(o Quantity) Quantity {
+	return Quantity{new(big.Int).Add(q.big(), o.big())}
+}
+
+// Sub returns q-o.
+func (q Quantity) Sub(o Quantity) Quantity {
+	return Quantity{new(big.Int).Sub(q.big(), o.big())}
+}
+
+// Neg returns -q.
+func (q Quantity) Neg() Quantity {
+	return Quantity{new(big.Int).Neg(q.big())}
+}
+
+// Cmp compares q and o and returns -1, 0 or +1.
+func (q Quantity) Cmp(o Quantity) int {
+	return q.big().Cmp(o.big())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of q.
+func (q Quantity) Sign() int {
+	return q.big().Sign()
+}
+
+// IsZero reports whether q is 0.
+func (q Quantity) IsZero() bool {
+	return q.Sign() == 0
+}
+
+// BigInt returns q as a new big.Int.
+func (q Quantity) BigInt() *big.Int {
+	return new(big.Int).Set(q.big())
+}
+
+// Uint64 returns q as a uint64. ok is false when q does not fit.
+func (q Quantity) Uint64() (v uint64, ok bool) {
+	b := q.big()
+	if !b.IsUint64() {
+		return 0, false
+	}
+	return b.Uint64(), true
+}
+
+// Int64 returns q as an int64. ok is false when q does not fit.
+func (q Quantity) Int64() (v int64, ok bool) {
+	b := q.big()
+	if !b.IsInt64() {
+		return 0, false
+	}
+	return b.Int64(), true
+}
+
+func (q Quantity) String() string {
+	return q.big().String()
+}
+
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	s, ok, err := numericJSON(data)
+	if err != nil || !ok {
+		*q = Quantity{}
+		return err
+	}
+
+	v, err := ParseQuantity(s)
+	if err != nil {
+		return err
+	}
+	*q = v
+	return nil
+}
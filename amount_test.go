@@ -0,0 +1,185 @@
+package tangocrypto_go
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseADA(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Lovelace
+	}{
+		{"0", 0},
+		{"1", 1_000_000},
+		{"12.5", 12_500_000},
+		{"0.000001", 1},
+		{".5", 500_000},
+		{"3.", 3_000_000},
+		{"45000000000", 45_000_000_000_000_000},
+		{"18446744073709.551615", math.MaxUint64},
+	}
+	for _, tt := range tests {
+		got, err := ParseADA(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseADA(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+
+	for _, in := range []string{
+		"", ".", "-1", "+1", "1.-5", "1.+5", "0.0000001", "1.5.0", "1e6", "abc", " 1",
+		"18446744073710",
+	} {
+		if got, err := ParseADA(in); err == nil {
+			t.Errorf("ParseADA(%q) = %d, want error", in, got)
+		}
+	}
+	for _, in := range []string{"18446744073709.551616", "18446744073710.0"} {
+		if _, err := ParseADA(in); !errors.Is(err, ErrOverflow) {
+			t.Errorf("ParseADA(%q) = %v, want ErrOverflow", in, err)
+		}
+	}
+}
+
+func TestLovelaceArithmetic(t *testing.T) {
+	const max = Lovelace(math.MaxUint64)
+
+	if v, err := max.Sub(1); err != nil || v != max-1 {
+		t.Errorf("Sub = %d, %v", v, err)
+	}
+	if v, err := (max - 1).Add(1); err != nil || v != max {
+		t.Errorf("Add = %d, %v", v, err)
+	}
+	if _, err := max.Add(1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("max+1: %v, want ErrOverflow", err)
+	}
+	if _, err := Lovelace(1).Sub(2); !errors.Is(err, ErrNegativeAmount) {
+		t.Errorf("1-2: %v, want ErrNegativeAmount", err)
+	}
+	if v, err := Lovelace(0).Sub(0); err != nil || v != 0 {
+		t.Errorf("0-0 = %d, %v", v, err)
+	}
+	if v, err := (max / 2).Mul(2); err != nil || v != max-1 {
+		t.Errorf("Mul = %d, %v", v, err)
+	}
+	if _, err := (max/2 + 1).Mul(2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Mul overflow: %v, want ErrOverflow", err)
+	}
+	if v, err := max.Mul(0); err != nil || v != 0 {
+		t.Errorf("max*0 = %d, %v", v, err)
+	}
+	if Lovelace(1).Cmp(2) != -1 || Lovelace(2).Cmp(2) != 0 || max.Cmp(0) != 1 {
+		t.Error("Cmp is not ordered")
+	}
+}
+
+func TestLovelaceADA(t *testing.T) {
+	for l, want := range map[Lovelace]string{
+		0:                        "0.000000",
+		1:                        "0.000001",
+		12_500_000:               "12.500000",
+		Lovelace(math.MaxUint64): "18446744073709.551615",
+	} {
+		if got := l.ADA(); got != want {
+			t.Errorf("Lovelace(%d).ADA() = %s, want %s", uint64(l), got, want)
+		}
+	}
+}
+
+func TestParseQuantity(t *testing.T) {
+	for _, in := range []string{"0", "-5", "18446744073709551616", "-18446744073709551616"} {
+		q, err := ParseQuantity(in)
+		if err != nil || q.String() != in {
+			t.Errorf("ParseQuantity(%q) = %s, %v", in, q, err)
+		}
+	}
+	for _, in := range []string{"", "+1", "1.0", "0x10", "1_000", " 1"} {
+		if q, err := ParseQuantity(in); err == nil {
+			t.Errorf("ParseQuantity(%q) = %s, want error", in, q)
+		}
+	}
+
+	if v, ok := mustQuantity(t, "18446744073709551615").Uint64(); !ok || v != math.MaxUint64 {
+		t.Errorf("Uint64 = %d, %v", v, ok)
+	}
+	if _, ok := mustQuantity(t, "18446744073709551616").Uint64(); ok {
+		t.Error("Uint64 of 2^64 succeeded")
+	}
+	if _, ok := mustQuantity(t, "9223372036854775808").Int64(); ok {
+		t.Error("Int64 of 2^63 succeeded")
+	}
+	if (Quantity{}).String() != "0" || !(Quantity{}).IsZero() {
+		t.Error("zero Quantity is not 0")
+	}
+}
+
+func TestNumericJSON(t *testing.T) {
+	var v struct {
+		Lovelace Lovelace `json:"lovelace"`
+		Quantity Quantity `json:"quantity"`
+		Int64    Int64    `json:"int64"`
+		Slot     NullSlot `json:"slot"`
+	}
+
+	tests := []struct {
+		json     string
+		lovelace Lovelace
+		quantity string
+		int64    Int64
+		slot     NullSlot
+	}{
+		{`{"lovelace":5,"quantity":-7,"int64":-9,"slot":11}`, 5, "-7", -9, NullSlot{11, true}},
+		{`{"lovelace":"5","quantity":"-7","int64":"-9","slot":"11"}`, 5, "-7", -9, NullSlot{11, true}},
+		{`{"lovelace":null,"quantity":null,"int64":null,"slot":null}`, 0, "0", 0, NullSlot{}},
+		{`{"lovelace":"","quantity":"","int64":"","slot":""}`, 0, "0", 0, NullSlot{}},
+		{
+			`{"lovelace":18446744073709551615,"quantity":18446744073709551616,"int64":"9223372036854775807","slot":18446744073709551615}`,
+			math.MaxUint64, "18446744073709551616", math.MaxInt64, NullSlot{math.MaxUint64, true},
+		},
+	}
+	for _, tt := range tests {
+		v.Lovelace, v.Quantity, v.Int64, v.Slot = 1, NewQuantity(1), 1, NullSlot{1, true}
+		if err := json.Unmarshal([]byte(tt.json), &v); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.json, err)
+			continue
+		}
+		if v.Lovelace != tt.lovelace || v.Quantity.String() != tt.quantity || v.Int64 != tt.int64 || v.Slot != tt.slot {
+			t.Errorf("Unmarshal(%s) = %+v", tt.json, v)
+		}
+	}
+
+	for _, in := range []string{
+		`{"lovelace":18446744073709551616}`,
+		`{"lovelace":-1}`,
+		`{"lovelace":1.5}`,
+		`{"lovelace":"+1"}`,
+		`{"lovelace":true}`,
+		`{"quantity":"+1"}`,
+		`{"quantity":1e3}`,
+		`{"quantity":[]}`,
+		`{"int64":9223372036854775808}`,
+		`{"int64":"x"}`,
+		`{"slot":-1}`,
+		`{"slot":"1.0"}`,
+	} {
+		if err := json.Unmarshal([]byte(in), &v); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded", in)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Lovelace Lovelace
+		Quantity Quantity
+		Set      NullSlot
+		Unset    NullSlot
+	}{math.MaxUint64, mustQuantity(t, "-18446744073709551616"), NullSlot{7, true}, NullSlot{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"Lovelace":18446744073709551615,"Quantity":-18446744073709551616,"Set":7,"Unset":null}`
+	if string(b) != want {
+		t.Errorf("Marshal = %s, want %s", b, want)
+	}
+}
@@ -0,0 +1,170 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ripoff2/tangocrypto-go/address"
+)
+
+const (
+	resourceAccounts      = "accounts"
+	resourceRewards       = "rewards"
+	resourceRegistrations = "registrations"
+	resourceMIRs          = "mirs"
+)
+
+// StakeAccount describes a stake account, identified by its reward address.
+type StakeAccount struct {
+	StakeAddress     string   `json:"stake_address"`
+	Active           bool     `json:"active"`
+	ActiveEpoch      int      `json:"active_epoch"`
+	ControlledAmount Lovelace `json:"controlled_amount"`
+	RewardsSum       Lovelace `json:"rewards_sum"`
+	WithdrawalsSum   Lovelace `json:"withdrawals_sum"`
+	ReservesSum      Lovelace `json:"reserves_sum"`
+	TreasurySum      Lovelace `json:"treasury_sum"`
+	// WithdrawableAmount is the amount of rewards available for withdrawal.
+	WithdrawableAmount Lovelace `json:"withdrawable_amount"`
+	// PoolID is the pool the account delegates to, empty when not delegated.
+	PoolID string `json:"pool_id"`
+}
+
+// StakeAccountAddress is an address associated with a stake account.
+type StakeAccountAddress struct {
+	Address string `json:"address"`
+}
+
+// StakeAccountReward is a reward earned by a stake account.
+type StakeAccountReward struct {
+	Epoch  int      `json:"epoch"`
+	Amount Lovelace `json:"amount"`
+	PoolID string   `json:"pool_id"`
+	// Type is the source of the reward, e.g. "member" or "leader".
+	Type string `json:"type"`
+}
+
+// StakeAccountHistory is the active stake of a stake account in an epoch.
+type StakeAccountHistory struct {
+	ActiveEpoch int      `json:"active_epoch"`
+	Amount      Lovelace `json:"amount"`
+	PoolID      string   `json:"pool_id"`
+}
+
+// StakeAccountDelegation is a delegation made by a stake account.
+type StakeAccountDelegation struct {
+	ActiveEpoch int      `json:"active_epoch"`
+	TxHash      string   `json:"tx_hash"`
+	Amount      Lovelace `json:"amount"`
+	PoolID      string   `json:"pool_id"`
+}
+
+// Stake account registration actions.
+const (
+	StakeActionRegistered   = "registered"
+	StakeActionDeregistered = "deregistered"
+)
+
+// StakeAccountRegistration is a registration or deregistration of a stake
+// account.
+type StakeAccountRegistration struct {
+	TxHash string `json:"tx_hash"`
+	Action string `json:"action"`
+}
+
+// StakeAccountWithdrawal is a reward withdrawal of a stake account.
+type StakeAccountWithdrawal struct {
+	TxHash string   `json:"tx_hash"`
+	Amount Lovelace `json:"amount"`
+}
+
+// StakeAccountMIR is a move of instantaneous rewards to a stake account.
+type StakeAccountMIR struct {
+	TxHash string   `json:"tx_hash"`
+	Amount Lovelace `json:"amount"`
+}
+
+// validateStakeAddress rejects malformed stake addresses before a request is
+// made.
+func validateStakeAddress(stakeAddress string) error {
+	addr, err := address.Parse(stakeAddress)
+	if err != nil {
+		return err
+	}
+	if addr.Type != address.Reward {
+		return fmt.Errorf("%w: %s address is not a stake address", address.ErrInvalidAddress, addr.Type)
+	}
+	return nil
+}
+
+// StakeAccount returns the stake account with the given stake address.
+func (c *apiClient) StakeAccount(ctx context.Context, stakeAddress string) (account StakeAccount, err error) {
+	if err = validateStakeAddress(stakeAddress); err != nil {
+		return
+	}
+	err = c.getJSON(ctx, nil, &account, resourceAccounts, stakeAddress)
+	return account, err
+}
+
+// StakeAccountAddresses returns a page of the addresses associated with a
+// stake account.
+func (c *apiClient) StakeAccountAddresses(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountAddress], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountAddress]{}, err
+	}
+	return getPage[StakeAccountAddress](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceAddresses)
+}
+
+// StakeAccountRewards returns a page of the rewards earned by a stake
+// account.
+func (c *apiClient) StakeAccountRewards(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountReward], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountReward]{}, err
+	}
+	return getPage[StakeAccountReward](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceRewards)
+}
+
+// StakeAccountHistory returns a page of the active stake of a stake account
+// per epoch.
+func (c *apiClient) StakeAccountHistory(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountHistory], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountHistory]{}, err
+	}
+	return getPage[StakeAccountHistory](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceHistory)
+}
+
+// StakeAccountDelegations returns a page of the delegations made by a stake
+// account.
+func (c *apiClient) StakeAccountDelegations(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountDelegation], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountDelegation]{}, err
+	}
+	return getPage[StakeAccountDelegation](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceDelegations)
+}
+
+// StakeAccountRegistrations returns a page of the registrations and
+// deregistrations of a stake account.
+func (c *apiClient) StakeAccountRegistrations(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountRegistration], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountRegistration]{}, err
+	}
+	return getPage[StakeAccountRegistration](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceRegistrations)
+}
+
+// StakeAccountWithdrawals returns a page of the reward withdrawals of a
+// stake account.
+func (c *apiClient) StakeAccountWithdrawals(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountWithdrawal], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountWithdrawal]{}, err
+	}
+	return getPage[StakeAccountWithdrawal](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceWithdrawals)
+}
+
+// StakeAccountMIRs returns a page of the moves of instantaneous rewards to a
+// stake account.
+func (c *apiClient) StakeAccountMIRs(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountMIR], error) {
+	if err := validateStakeAddress(stakeAddress); err != nil {
+		return Page[StakeAccountMIR]{}, err
+	}
+	return getPage[StakeAccountMIR](ctx, c, opts, nil, resourceAccounts, stakeAddress, resourceMIRs)
+}
@@ -3,9 +3,13 @@ package tangocrypto_go
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+
+	"github.com/ripoff2/tangocrypto-go/address"
 )
 
 const (
@@ -17,43 +21,32 @@ const (
 )
 
 type AddressSummary struct {
-	Network           string `json:"network"`
-	Address           string `json:"address"`
-	StakeAddress      string `json:"stake_address"`
-	Balance           int    `json:"balance"`
-	TransactionsCount int    `json:"transactions_count"`
+	Network           string   `json:"network"`
+	Address           string   `json:"address"`
+	StakeAddress      string   `json:"stake_address"`
+	Balance           Lovelace `json:"balance"`
+	TransactionsCount int      `json:"transactions_count"`
 }
 
-type AddrUTXOs struct {
-	Data   []Data      `json:"data"`
-	Cursor interface{} `json:"cursor"`
-}
+// AddrUTXOs is a page of UTxOs held by an address.
+type AddrUTXOs = Page[Data]
 
 type Assets struct {
-	PolicyID    string `json:"policy_id"`
-	AssetName   string `json:"asset_name"`
-	Fingerprint string `json:"fingerprint"`
-	Quantity    int    `json:"quantity"`
-}
-
-type List struct {
-	Bytes string `json:"bytes"`
-}
-
-type Fields struct {
-	List  []List `json:"list,omitempty"`
-	Bytes string `json:"bytes,omitempty"`
-}
-
-type Value struct {
-	Fields      []Fields `json:"fields"`
-	Constructor int      `json:"constructor"`
+	PolicyID    string   `json:"policy_id"`
+	AssetName   string   `json:"asset_name"`
+	Fingerprint string   `json:"fingerprint"`
+	Quantity    Quantity `json:"quantity"`
 }
 
+// InlineDatum is a datum stored in a UTxO. Value holds the decoded Plutus
+// data, nil when the API does not provide it or when it cannot be decoded,
+// see ValueErr.
 type InlineDatum struct {
-	Hash     string `json:"hash"`
-	Value    Value  `json:"value"`
-	ValueRaw string `json:"value_raw"`
+	Hash     string     `json:"hash"`
+	Value    PlutusData `json:"value"`
+	ValueRaw string     `json:"value_raw"`
+
+	valueErr error
 }
 
 type Datum struct {
@@ -72,14 +65,74 @@ type Data struct {
 	Address     string      `json:"address"`
 	Hash        string      `json:"hash"`
 	Index       int         `json:"index"`
-	Value       int         `json:"value"`
+	Value       Lovelace    `json:"value"`
 	HasScript   bool        `json:"has_script"`
 	Assets      []Assets    `json:"assets"`
 	InlineDatum InlineDatum `json:"inline_datum"`
 	Script      Script      `json:"script"`
 }
 
+// AddressTransaction is a transaction involving an address.
+type AddressTransaction struct {
+	TxHash    string `json:"tx_hash"`
+	TxIndex   int    `json:"tx_index"`
+	BlockNo   int    `json:"block_no"`
+	SlotNo    int    `json:"slot_no"`
+	BlockTime string `json:"block_time"`
+}
+
+// AddressTransactionsOptions selects the transactions returned by
+// AddressTransactions. Bounds are inclusive and zero means unbounded; block
+// and slot bounds cannot be combined.
+type AddressTransactionsOptions struct {
+	PageOptions
+
+	FromBlock int
+	ToBlock   int
+	FromSlot  int
+	ToSlot    int
+}
+
+func (o AddressTransactionsOptions) query() (url.Values, error) {
+	byBlock := o.FromBlock != 0 || o.ToBlock != 0
+	bySlot := o.FromSlot != 0 || o.ToSlot != 0
+	if byBlock && bySlot {
+		return nil, errors.New("address transactions: block and slot bounds cannot be combined")
+	}
+
+	query := url.Values{}
+	bound := func(key string, v int) {
+		if v != 0 {
+			query.Set(key, strconv.Itoa(v))
+		}
+	}
+	bound("from_block", o.FromBlock)
+	bound("to_block", o.ToBlock)
+	bound("from_slot", o.FromSlot)
+	bound("to_slot", o.ToSlot)
+	return query, nil
+}
+
+// AddressAmount is an amount of lovelace and native assets.
+type AddressAmount struct {
+	Value  Lovelace `json:"value"`
+	Assets []Assets `json:"assets"`
+}
+
+// AddressTotal is the total amount received and sent by an address over its
+// whole history.
+type AddressTotal struct {
+	Address  string        `json:"address"`
+	Received AddressAmount `json:"received"`
+	Sent     AddressAmount `json:"sent"`
+	TxCount  int           `json:"tx_count"`
+}
+
 func (c *apiClient) AddressSummary(ctx context.Context, address string) (addressSum AddressSummary, err error) {
+	if err = validateAddress(address); err != nil {
+		return
+	}
+
 	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s", c.server, c.appID, resourceAddress, address))
 	if err != nil {
 		return
@@ -103,28 +156,100 @@ func (c *apiClient) AddressSummary(ctx context.Context, address string) (address
 	return addressSum, nil
 }
 
-// TODO: add pagination support
-// add query params to the request
+// AddressUTXOs returns the first page of UTxOs held by address. Use
+// AddressUTXOsPage or UTXOIterator to read the remaining pages.
 func (c *apiClient) AddressUTXOs(ctx context.Context, address string) (utxos AddrUTXOs, err error) {
-	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s/%s", c.server, c.appID, resourceAddresses, address, resourceUTXOs))
-	if err != nil {
-		return
+	return c.AddressUTXOsPage(ctx, address, PageOptions{})
+}
+
+// AddressUTXOsPage returns a single page of UTxOs held by address.
+func (c *apiClient) AddressUTXOsPage(ctx context.Context, address string, opts PageOptions) (AddrUTXOs, error) {
+	if err := validateAddress(address); err != nil {
+		return AddrUTXOs{}, err
 	}
+	return getPage[Data](ctx, c, opts, nil, resourceAddresses, address, resourceUTXOs)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+// AddressTransactions returns a page of the transactions involving address.
+func (c *apiClient) AddressTransactions(ctx context.Context, address string, opts AddressTransactionsOptions) (Page[AddressTransaction], error) {
+	if err := validateAddress(address); err != nil {
+		return Page[AddressTransaction]{}, err
+	}
+	query, err := opts.query()
 	if err != nil {
-		return
+		return Page[AddressTransaction]{}, err
 	}
+	return getPage[AddressTransaction](ctx, c, opts.PageOptions, query, resourceAddresses, address, resourceTransactions)
+}
 
-	resp, err := c.handleRequest(req)
-	if err != nil {
+// AddressTotal returns the total amount received and sent by address.
+func (c *apiClient) AddressTotal(ctx context.Context, address string) (total AddressTotal, err error) {
+	if err = validateAddress(address); err != nil {
 		return
 	}
-	defer resp.Body.Close()
+	err = c.getJSON(ctx, nil, &total, resourceAddresses, address, resourceTotal)
+	return total, err
+}
 
-	if err = json.NewDecoder(resp.Body).Decode(&utxos); err != nil {
-		return
+// UTXOIterator walks every UTxO of an address, following the pagination
+// cursor until the last page.
+type UTXOIterator struct {
+	*Pager[Data]
+}
+
+// NewUTXOIterator returns an iterator over the UTxOs of address. opts.Cursor,
+// if set, is used as the starting position.
+func NewUTXOIterator(client APIClient, address string, opts PageOptions) *UTXOIterator {
+	fetch := func(ctx context.Context, opts PageOptions) (Page[Data], error) {
+		return client.AddressUTXOsPage(ctx, address, opts)
 	}
+	return &UTXOIterator{NewPager(fetch, opts)}
+}
+
+// UTXO returns the UTxO at the current position of the iterator.
+func (it *UTXOIterator) UTXO() Data {
+	return it.Item()
+}
+
+// ForEachUTXO calls fn for every UTxO held by address, across all pages.
+// Iteration stops at the first error returned by fn or by the API.
+func ForEachUTXO(ctx context.Context, client APIClient, address string, opts PageOptions, fn func(Data) error) error {
+	for utxo, err := range NewUTXOIterator(client, address, opts).All(ctx) {
+		if err != nil {
+			return err
+		}
+		if err := fn(utxo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	return utxos, nil
+// validateAddress rejects malformed addresses before a request is made.
+func validateAddress(addr string) error {
+	_, err := address.Parse(addr)
+	return err
+}
+
+// VerifyStakeAddress checks that StakeAddress is the stake address derived
+// locally from Address. Addresses without a locally known stake credential
+// must have an empty StakeAddress, except pointer addresses which are not
+// checked.
+func (s AddressSummary) VerifyStakeAddress() error {
+	addr, err := address.Parse(s.Address)
+	if err != nil {
+		return err
+	}
+
+	stake, err := addr.StakeAddress()
+	switch {
+	case err == nil:
+		if stake.String() != s.StakeAddress {
+			return fmt.Errorf("address: stake address mismatch: derived %s, got %s", stake, s.StakeAddress)
+		}
+	case addr.Type == address.Pointer:
+	case s.StakeAddress != "":
+		return fmt.Errorf("address: stake address mismatch: %s address has none, got %s", addr.Type, s.StakeAddress)
+	}
+	return nil
 }
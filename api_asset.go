@@ -0,0 +1,160 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	resourceAssets      = "assets"
+	resourceFingerprint = "fingerprint"
+	resourceHistory     = "history"
+	resourcePolicies    = "policies"
+)
+
+// ErrInvalidAsset is returned when a policy ID, asset name or fingerprint is
+// malformed, before any request is made.
+var ErrInvalidAsset = errors.New("tangocrypto: invalid asset")
+
+// AssetInfo describes a native asset.
+type AssetInfo struct {
+	PolicyID          string   `json:"policy_id"`
+	AssetName         string   `json:"asset_name"`
+	Fingerprint       string   `json:"fingerprint"`
+	Quantity          Quantity `json:"quantity"`
+	MintOrBurnCount   int      `json:"mint_or_burn_count"`
+	InitialMintTxHash string   `json:"initial_mint_tx_hash"`
+
+	// OnchainMetadata is the metadata attached to the minting transaction,
+	// such as CIP-25 NFT metadata, nil when absent.
+	OnchainMetadata json.RawMessage `json:"onchain_metadata"`
+	// OnchainMetadataStandard names the standard OnchainMetadata follows,
+	// e.g. "CIP25v1".
+	OnchainMetadataStandard string `json:"onchain_metadata_standard"`
+
+	// Metadata is the off-chain metadata of the token registry, nil when
+	// the asset is not registered.
+	Metadata *AssetRegistryMetadata `json:"metadata"`
+}
+
+// AssetRegistryMetadata is the metadata of an asset in the Cardano token
+// registry.
+type AssetRegistryMetadata struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Ticker      string `json:"ticker"`
+	URL         string `json:"url"`
+	Logo        string `json:"logo"`
+	Decimals    int    `json:"decimals"`
+}
+
+// AssetHolder is an address holding an asset.
+type AssetHolder struct {
+	Address  string   `json:"address"`
+	Quantity Quantity `json:"quantity"`
+}
+
+// AssetTransaction is a transaction involving an asset.
+type AssetTransaction struct {
+	TxHash    string `json:"tx_hash"`
+	TxIndex   int    `json:"tx_index"`
+	BlockNo   int    `json:"block_no"`
+	BlockTime string `json:"block_time"`
+}
+
+// Asset history actions.
+const (
+	AssetActionMinted = "minted"
+	AssetActionBurned = "burned"
+)
+
+// AssetHistoryEntry is a mint or a burn of an asset.
+type AssetHistoryEntry struct {
+	TxHash   string   `json:"tx_hash"`
+	Action   string   `json:"action"`
+	Quantity Quantity `json:"quantity"`
+}
+
+// validatePolicyID rejects policy IDs that are not 28 hex encoded bytes.
+func validatePolicyID(policyID string) error {
+	if b, err := hex.DecodeString(policyID); err != nil || len(b) != 28 {
+		return fmt.Errorf("%w: policy id %q is not 28 hex encoded bytes", ErrInvalidAsset, policyID)
+	}
+	return nil
+}
+
+// assetPath returns the path of resource under an asset. Assets are
+// addressed by policy ID and name, or by their unit, i.e. the policy ID
+// alone, when the name is empty.
+func assetPath(policyID, assetName string, resource ...string) ([]string, error) {
+	if err := validatePolicyID(policyID); err != nil {
+		return nil, err
+	}
+	if b, err := hex.DecodeString(assetName); err != nil || len(b) > 32 {
+		return nil, fmt.Errorf("%w: asset name %q is not at most 32 hex encoded bytes", ErrInvalidAsset, assetName)
+	}
+
+	path := []string{resourceAssets, policyID}
+	if assetName != "" {
+		path = append(path, assetName)
+	}
+	return append(path, resource...), nil
+}
+
+// Asset returns the asset identified by its policy ID and hex encoded name,
+// which may be empty.
+func (c *apiClient) Asset(ctx context.Context, policyID, assetName string) (asset AssetInfo, err error) {
+	path, err := assetPath(policyID, assetName)
+	if err != nil {
+		return
+	}
+	err = c.getJSON(ctx, nil, &asset, path...)
+	return asset, err
+}
+
+// AssetByFingerprint returns the asset with the given CIP-14 fingerprint.
+func (c *apiClient) AssetByFingerprint(ctx context.Context, fingerprint string) (asset AssetInfo, err error) {
+	if _, err = ParseAssetFingerprint(fingerprint); err != nil {
+		return asset, fmt.Errorf("%w: %v", ErrInvalidAsset, err)
+	}
+	err = c.getJSON(ctx, nil, &asset, resourceAssets, resourceFingerprint, fingerprint)
+	return asset, err
+}
+
+// AssetAddresses returns a page of the addresses holding an asset.
+func (c *apiClient) AssetAddresses(ctx context.Context, policyID, assetName string, opts PageOptions) (Page[AssetHolder], error) {
+	path, err := assetPath(policyID, assetName, resourceAddresses)
+	if err != nil {
+		return Page[AssetHolder]{}, err
+	}
+	return getPage[AssetHolder](ctx, c, opts, nil, path...)
+}
+
+// AssetTransactions returns a page of the transactions involving an asset.
+func (c *apiClient) AssetTransactions(ctx context.Context, policyID, assetName string, opts PageOptions) (Page[AssetTransaction], error) {
+	path, err := assetPath(policyID, assetName, resourceTransactions)
+	if err != nil {
+		return Page[AssetTransaction]{}, err
+	}
+	return getPage[AssetTransaction](ctx, c, opts, nil, path...)
+}
+
+// AssetHistory returns a page of the mints and burns of an asset.
+func (c *apiClient) AssetHistory(ctx context.Context, policyID, assetName string, opts PageOptions) (Page[AssetHistoryEntry], error) {
+	path, err := assetPath(policyID, assetName, resourceHistory)
+	if err != nil {
+		return Page[AssetHistoryEntry]{}, err
+	}
+	return getPage[AssetHistoryEntry](ctx, c, opts, nil, path...)
+}
+
+// PolicyAssets returns a page of the assets minted under a policy.
+func (c *apiClient) PolicyAssets(ctx context.Context, policyID string, opts PageOptions) (Page[Assets], error) {
+	if err := validatePolicyID(policyID); err != nil {
+		return Page[Assets]{}, err
+	}
+	return getPage[Assets](ctx, c, opts, nil, resourcePolicies, policyID, resourceAssets)
+}
@@ -0,0 +1,72 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAssetPaths(t *testing.T) {
+	const policy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"data":[],"cursor":null}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		path string
+	}{
+		{"empty name", func() error { _, err := client.Asset(ctx, policy, ""); return err },
+			"/app/v1/assets/" + policy},
+		{"named", func() error { _, err := client.Asset(ctx, policy, "504154415445"); return err },
+			"/app/v1/assets/" + policy + "/504154415445"},
+		{"empty name addresses", func() error { _, err := client.AssetAddresses(ctx, policy, "", PageOptions{}); return err },
+			"/app/v1/assets/" + policy + "/addresses"},
+		{"empty name transactions", func() error { _, err := client.AssetTransactions(ctx, policy, "", PageOptions{}); return err },
+			"/app/v1/assets/" + policy + "/transactions"},
+		{"named history", func() error { _, err := client.AssetHistory(ctx, policy, "504154415445", PageOptions{}); return err },
+			"/app/v1/assets/" + policy + "/504154415445/history"},
+		{"policy", func() error { _, err := client.PolicyAssets(ctx, policy, PageOptions{}); return err },
+			"/app/v1/policies/" + policy + "/assets"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths = nil
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			if len(paths) != 1 || paths[0] != tt.path {
+				t.Errorf("requested %v, want %s", paths, tt.path)
+			}
+		})
+	}
+
+	paths = nil
+	invalid := []func() error{
+		func() error { _, err := client.Asset(ctx, "", ""); return err },
+		func() error { _, err := client.Asset(ctx, policy[:54], "00"); return err },
+		func() error { _, err := client.Asset(ctx, "zz"+policy[2:], ""); return err },
+		func() error { _, err := client.Asset(ctx, policy, "0"); return err },
+		func() error { _, err := client.AssetHistory(ctx, policy, "../x", PageOptions{}); return err },
+		func() error { _, err := client.PolicyAssets(ctx, policy+"00", PageOptions{}); return err },
+		func() error { _, err := client.AssetByFingerprint(ctx, "asset1invalid"); return err },
+	}
+	for i, call := range invalid {
+		if err := call(); !errors.Is(err, ErrInvalidAsset) {
+			t.Errorf("invalid call %d = %v, want ErrInvalidAsset", i, err)
+		}
+	}
+	if len(paths) != 0 {
+		t.Errorf("invalid calls sent requests: %v", paths)
+	}
+}
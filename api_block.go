@@ -2,56 +2,94 @@ package tangocrypto_go
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"net/url"
+	"strconv"
 )
 
 const (
-	blocksResource = "blocks"
-	latestResource = "latest"
+	blocksResource   = "blocks"
+	latestResource   = "latest"
+	slotResource     = "slot"
+	nextResource     = "next"
+	previousResource = "previous"
 )
 
-type LatestBlock struct {
-	Hash          string `json:"hash"`
-	EpochNo       int    `json:"epoch_no"`
-	SlotNo        int    `json:"slot_no"`
-	EpochSlotNo   int    `json:"epoch_slot_no"`
-	BlockNo       int    `json:"block_no"`
-	PreviousBlock int    `json:"previous_block"`
-	NextBlock     int    `json:"next_block"`
-	SlotLeader    string `json:"slot_leader"`
-	OutSum        int    `json:"out_sum"`
-	Fees          int    `json:"fees"`
-	Confirmations int    `json:"confirmations"`
-	Size          int    `json:"size"`
-	Time          string `json:"time"`
-	TxCount       int    `json:"tx_count"`
-	VrfKey        string `json:"vrf_key"`
-	OpCert        string `json:"op_cert"`
-}
-
-func (c *apiClient) LatestBlock(ctx context.Context) (b LatestBlock, err error) {
-	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s", c.server, c.appID, blocksResource, latestResource))
-	if err != nil {
-		return
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
-	if err != nil {
-		return
-	}
-
-	resp, err := c.handleRequest(req)
-	if err != nil {
-		return
-	}
-	defer resp.Body.Close()
-
-	if err = json.NewDecoder(resp.Body).Decode(&b); err != nil {
-		return
-	}
-
-	return b, nil
-}
\ No newline at end of file
+// Block describes a block of the chain. Transactions only embed the hash,
+// epoch, number and slot of their block.
+type Block struct {
+	Hash          string   `json:"hash"`
+	EpochNo       int      `json:"epoch_no"`
+	SlotNo        int      `json:"slot_no"`
+	EpochSlotNo   int      `json:"epoch_slot_no"`
+	BlockNo       int      `json:"block_no"`
+	PreviousBlock int      `json:"previous_block"`
+	NextBlock     int      `json:"next_block"`
+	SlotLeader    string   `json:"slot_leader"`
+	OutSum        Lovelace `json:"out_sum"`
+	Fees          Lovelace `json:"fees"`
+	Confirmations int      `json:"confirmations"`
+	Size          int      `json:"size"`
+	Time          string   `json:"time"`
+	TxCount       int      `json:"tx_count"`
+	VrfKey        string   `json:"vrf_key"`
+	OpCert        string   `json:"op_cert"`
+}
+
+// BlockTransaction summarizes a transaction included in a block. Use
+// APIClient.Transaction to get its full content.
+type BlockTransaction struct {
+	Hash          string   `json:"hash"`
+	BlockIndex    int      `json:"block_index"`
+	OutSum        Lovelace `json:"out_sum"`
+	Fee           Lovelace `json:"fee"`
+	Deposit       Int64    `json:"deposit"`
+	Size          int      `json:"size"`
+	ScriptSize    int      `json:"script_size"`
+	ValidContract bool     `json:"valid_contract"`
+}
+
+// LatestBlock is the block returned by APIClient.LatestBlock.
+//
+// Deprecated: use Block.
+type LatestBlock = Block
+
+// LatestBlock returns the tip of the chain.
+func (c *apiClient) LatestBlock(ctx context.Context) (b Block, err error) {
+	err = c.getJSON(ctx, nil, &b, blocksResource, latestResource)
+	return b, err
+}
+
+// BlockByHash returns the block with the given hash.
+func (c *apiClient) BlockByHash(ctx context.Context, hash string) (b Block, err error) {
+	err = c.getJSON(ctx, nil, &b, blocksResource, hash)
+	return b, err
+}
+
+// BlockByNumber returns the block at the given height.
+func (c *apiClient) BlockByNumber(ctx context.Context, number int) (b Block, err error) {
+	err = c.getJSON(ctx, nil, &b, blocksResource, strconv.Itoa(number))
+	return b, err
+}
+
+// BlockBySlot returns the block minted in the given absolute slot.
+func (c *apiClient) BlockBySlot(ctx context.Context, slot int) (b Block, err error) {
+	err = c.getJSON(ctx, nil, &b, blocksResource, slotResource, strconv.Itoa(slot))
+	return b, err
+}
+
+// NextBlocks returns a page of the blocks following the block identified by
+// hashOrNumber.
+func (c *apiClient) NextBlocks(ctx context.Context, hashOrNumber string, opts PageOptions) (Page[Block], error) {
+	return getPage[Block](ctx, c, opts, nil, blocksResource, hashOrNumber, nextResource)
+}
+
+// PreviousBlocks returns a page of the blocks preceding the block identified
+// by hashOrNumber.
+func (c *apiClient) PreviousBlocks(ctx context.Context, hashOrNumber string, opts PageOptions) (Page[Block], error) {
+	return getPage[Block](ctx, c, opts, nil, blocksResource, hashOrNumber, previousResource)
+}
+
+// BlockTransactions returns a page of the transactions included in the block
+// identified by hashOrNumber, in block order.
+func (c *apiClient) BlockTransactions(ctx context.Context, hashOrNumber string, opts PageOptions) (Page[BlockTransaction], error) {
+	return getPage[BlockTransaction](ctx, c, opts, nil, blocksResource, hashOrNumber, resourceTransactions)
+}
@@ -0,0 +1,29 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLatestBlock(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"hash":"abcd"}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+	block, err := client.LatestBlock(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block.Hash != "abcd" {
+		t.Errorf("LatestBlock = %+v", block)
+	}
+	if len(paths) != 1 || paths[0] != "/app/v1/blocks/latest" {
+		t.Errorf("requested %v, want /app/v1/blocks/latest", paths)
+	}
+}
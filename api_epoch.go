@@ -2,10 +2,10 @@ package tangocrypto_go
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -17,13 +17,13 @@ const (
 
 type EpochParameters struct {
 	EpochNo               int       `json:"epoch_no"`
-	MinFeeA               int       `json:"min_fee_a"`
-	MinFeeB               int       `json:"min_fee_b"`
+	MinFeeA               Lovelace  `json:"min_fee_a"`
+	MinFeeB               Lovelace  `json:"min_fee_b"`
 	MaxBlockSize          int       `json:"max_block_size"`
 	MaxTxSize             int       `json:"max_tx_size"`
 	MaxBlockHeaderSize    int       `json:"max_block_header_size"`
-	KeyDeposit            int       `json:"key_deposit"`
-	PoolDeposit           int       `json:"pool_deposit"`
+	KeyDeposit            Lovelace  `json:"key_deposit"`
+	PoolDeposit           Lovelace  `json:"pool_deposit"`
 	MaxEpoch              int       `json:"max_epoch"`
 	OptimalPoolCount      int       `json:"optimal_pool_count"`
 	InfluenceA0           float64   `json:"influence_a0"`
@@ -33,10 +33,10 @@ type EpochParameters struct {
 	ExtraEntropy          string    `json:"extra_entropy"`
 	ProtocolMajor         int       `json:"protocol_major"`
 	ProtocolMinor         int       `json:"protocol_minor"`
-	MinUtxo               int       `json:"min_utxo"`
-	MinPoolCost           int       `json:"min_pool_cost"`
+	MinUtxo               Lovelace  `json:"min_utxo"`
+	MinPoolCost           Lovelace  `json:"min_pool_cost"`
 	Nonce                 string    `json:"nonce"`
-	CoinsPerUtxoSize      int       `json:"coins_per_utxo_size"`
+	CoinsPerUtxoSize      Lovelace  `json:"coins_per_utxo_size"`
 	PriceMem              float64   `json:"price_mem"`
 	PriceStep             float64   `json:"price_step"`
 	MaxTxExMem            int       `json:"max_tx_ex_mem"`
@@ -407,8 +407,8 @@ type CostModel struct {
 }
 
 type CurrentEpoch struct {
-	OutSum    string    `json:"out_sum"`
-	Fees      string    `json:"fees"`
+	OutSum    Lovelace  `json:"out_sum"`
+	Fees      Lovelace  `json:"fees"`
 	TxCount   int       `json:"tx_count"`
 	BlkCount  int       `json:"blk_count"`
 	No        int       `json:"no"`
@@ -416,51 +416,123 @@ type CurrentEpoch struct {
 	EndTime   time.Time `json:"end_time"`
 }
 
-// ProtocolParameters Retrieves the protocol parameters for a given epoch.
-func (c *apiClient) ProtocolParameters(ctx context.Context, epochNumber string) (eParams EpochParameters, err error) {
-	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s/%s", c.server, c.appID, resourceEpochs, epochNumber, resourceParameters))
-	if err != nil {
-		return
-	}
+// Epoch describes an epoch.
+type Epoch struct {
+	No          int       `json:"no"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	OutSum      Lovelace  `json:"out_sum"`
+	Fees        Lovelace  `json:"fees"`
+	TxCount     int       `json:"tx_count"`
+	BlkCount    int       `json:"blk_count"`
+	ActiveStake Lovelace  `json:"active_stake"`
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
-	if err != nil {
-		return
-	}
+// EpochStake is the active stake of a stake account in an epoch.
+type EpochStake struct {
+	StakeAddress string   `json:"stake_address"`
+	PoolID       string   `json:"pool_id"`
+	Amount       Lovelace `json:"amount"`
+}
+
+// EpochNumber identifies the epoch of a request. LatestEpoch selects the
+// latest epoch.
+type EpochNumber int
+
+// LatestEpoch selects the latest epoch.
+const LatestEpoch EpochNumber = -1
 
-	resp, err := c.handleRequest(req)
-	if err != nil {
-		return
+// ErrInvalidEpoch is returned, before any request is made, for negative
+// epoch numbers other than LatestEpoch and for empty epoch ranges.
+var ErrInvalidEpoch = errors.New("tangocrypto: invalid epoch")
+
+// validateEpoch rejects negative epoch numbers.
+func validateEpoch(number int) error {
+	if number < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidEpoch, number)
 	}
-	defer resp.Body.Close()
+	return nil
+}
 
-	if err = json.NewDecoder(resp.Body).Decode(&eParams); err != nil {
-		return
+func (n EpochNumber) String() string {
+	if n == LatestEpoch {
+		return "latest"
 	}
+	return strconv.Itoa(int(n))
+}
 
-	return eParams, nil
+// ProtocolParameters Retrieves the protocol parameters for a given epoch, or
+// for the latest epoch with LatestEpoch.
+func (c *apiClient) ProtocolParameters(ctx context.Context, epoch EpochNumber) (eParams EpochParameters, err error) {
+	if epoch != LatestEpoch {
+		if err = validateEpoch(int(epoch)); err != nil {
+			return eParams, err
+		}
+	}
+	err = c.getJSON(ctx, nil, &eParams, resourceEpochs, epoch.String(), resourceParameters)
+	return eParams, err
 }
 
 func (c *apiClient) CurrentEpoch(ctx context.Context) (cEpoch CurrentEpoch, err error) {
-	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s", c.server, c.appID, resourceEpochs, resourceCurrent))
-	if err != nil {
-		return
+	err = c.getJSON(ctx, nil, &cEpoch, resourceEpochs, resourceCurrent)
+	return cEpoch, err
+}
+
+// Epoch returns the epoch with the given number.
+func (c *apiClient) Epoch(ctx context.Context, number int) (epoch Epoch, err error) {
+	if err = validateEpoch(number); err != nil {
+		return epoch, err
+	}
+	err = c.getJSON(ctx, nil, &epoch, resourceEpochs, strconv.Itoa(number))
+	return epoch, err
+}
+
+// EpochRange returns a page of the epochs numbered from through to, both
+// inclusive.
+func (c *apiClient) EpochRange(ctx context.Context, from, to int, opts PageOptions) (Page[Epoch], error) {
+	if err := validateEpoch(from); err != nil {
+		return Page[Epoch]{}, err
+	}
+	if from > to {
+		return Page[Epoch]{}, fmt.Errorf("%w: range %d to %d is empty", ErrInvalidEpoch, from, to)
 	}
+	query := url.Values{}
+	query.Set("from", strconv.Itoa(from))
+	query.Set("to", strconv.Itoa(to))
+	return getPage[Epoch](ctx, c, opts, query, resourceEpochs)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
-	if err != nil {
-		return
+// EpochStakeDistribution returns a page of the active stake distribution of
+// an epoch.
+func (c *apiClient) EpochStakeDistribution(ctx context.Context, number int, opts PageOptions) (Page[EpochStake], error) {
+	if err := validateEpoch(number); err != nil {
+		return Page[EpochStake]{}, err
 	}
+	return getPage[EpochStake](ctx, c, opts, nil, resourceEpochs, strconv.Itoa(number), resourceStakes)
+}
 
-	resp, err := c.handleRequest(req)
-	if err != nil {
-		return
+// EpochStakeByPool returns a page of the active stake delegated to a pool in
+// an epoch.
+func (c *apiClient) EpochStakeByPool(ctx context.Context, number int, poolID string, opts PageOptions) (Page[EpochStake], error) {
+	if err := validateEpoch(number); err != nil {
+		return Page[EpochStake]{}, err
 	}
-	defer resp.Body.Close()
+	return getPage[EpochStake](ctx, c, opts, nil, resourceEpochs, strconv.Itoa(number), resourceStakes, poolID)
+}
 
-	if err = json.NewDecoder(resp.Body).Decode(&cEpoch); err != nil {
-		return
+// EpochBlocks returns a page of the blocks minted in an epoch.
+func (c *apiClient) EpochBlocks(ctx context.Context, number int, opts PageOptions) (Page[Block], error) {
+	if err := validateEpoch(number); err != nil {
+		return Page[Block]{}, err
 	}
+	return getPage[Block](ctx, c, opts, nil, resourceEpochs, strconv.Itoa(number), blocksResource)
+}
 
-	return cEpoch, nil
+// EpochBlocksByPool returns a page of the blocks minted by a pool in an
+// epoch.
+func (c *apiClient) EpochBlocksByPool(ctx context.Context, number int, poolID string, opts PageOptions) (Page[Block], error) {
+	if err := validateEpoch(number); err != nil {
+		return Page[Block]{}, err
+	}
+	return getPage[Block](ctx, c, opts, nil, resourceEpochs, strconv.Itoa(number), blocksResource, poolID)
 }
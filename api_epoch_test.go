@@ -0,0 +1,94 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProtocolParametersEpoch(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"epoch_no":300}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+	ctx := context.Background()
+
+	for epoch, path := range map[EpochNumber]string{
+		LatestEpoch: "/app/v1/epochs/latest/parameters",
+		0:           "/app/v1/epochs/0/parameters",
+		300:         "/app/v1/epochs/300/parameters",
+	} {
+		paths = nil
+		if _, err := client.ProtocolParameters(ctx, epoch); err != nil {
+			t.Fatalf("epoch %s: %v", epoch, err)
+		}
+		if len(paths) != 1 || paths[0] != path {
+			t.Errorf("epoch %s requested %v, want %s", epoch, paths, path)
+		}
+	}
+
+	paths = nil
+	for _, epoch := range []EpochNumber{-2, -300} {
+		if _, err := client.ProtocolParameters(ctx, epoch); !errors.Is(err, ErrInvalidEpoch) {
+			t.Errorf("epoch %d: err = %v, want ErrInvalidEpoch", epoch, err)
+		}
+		if epoch.String() == "latest" {
+			t.Errorf("EpochNumber(%d).String() = latest", epoch)
+		}
+	}
+	if len(paths) != 0 {
+		t.Errorf("invalid epochs sent requests: %v", paths)
+	}
+}
+
+func TestEpochValidation(t *testing.T) {
+	const pool = "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Write([]byte(`{"data":[],"cursor":null}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+	ctx := context.Background()
+
+	calls := func(number int) []func() error {
+		return []func() error{
+			func() error { _, err := client.EpochStakeDistribution(ctx, number, PageOptions{}); return err },
+			func() error { _, err := client.EpochStakeByPool(ctx, number, pool, PageOptions{}); return err },
+			func() error { _, err := client.EpochBlocks(ctx, number, PageOptions{}); return err },
+			func() error { _, err := client.EpochBlocksByPool(ctx, number, pool, PageOptions{}); return err },
+			func() error { _, err := client.EpochRange(ctx, number, number, PageOptions{}); return err },
+		}
+	}
+
+	for i, call := range calls(0) {
+		if err := call(); err != nil {
+			t.Errorf("call %d with epoch 0: %v", i, err)
+		}
+	}
+	if len(paths) != 5 || paths[4] != "/app/v1/epochs?from=0&to=0" {
+		t.Errorf("requested %v", paths)
+	}
+
+	paths = nil
+	for i, call := range append(calls(-5),
+		func() error { _, err := client.Epoch(ctx, -1); return err },
+		func() error { _, err := client.EpochRange(ctx, 10, 9, PageOptions{}); return err },
+	) {
+		if err := call(); !errors.Is(err, ErrInvalidEpoch) {
+			t.Errorf("call %d: err = %v, want ErrInvalidEpoch", i, err)
+		}
+	}
+	if len(paths) != 0 {
+		t.Errorf("invalid epochs sent requests: %v", paths)
+	}
+}
@@ -0,0 +1,251 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	resourcePools      = "pools"
+	resourceRelays     = "relays"
+	resourceDelegators = "delegators"
+	resourceUpdates    = "updates"
+)
+
+// maxPoolMetadataSize is the maximum size of off-chain pool metadata
+// documents set by CIP-6.
+const maxPoolMetadataSize = 512
+
+// defaultMetadataTimeout limits the download of off-chain metadata when
+// APIClientOptions.MetadataHTTPClient is not set.
+const defaultMetadataTimeout = 10 * time.Second
+
+// ErrPoolMetadataHashMismatch is returned by PoolMetadata when the off-chain
+// metadata does not match the hash registered on chain.
+var ErrPoolMetadataHashMismatch = errors.New("tangocrypto: pool metadata hash mismatch")
+
+// PoolStatus filters the pools returned by Pools.
+type PoolStatus string
+
+const (
+	PoolsAll      PoolStatus = ""
+	PoolsRetired  PoolStatus = "retired"
+	PoolsRetiring PoolStatus = "retiring"
+)
+
+// PoolSummary identifies a stake pool in a listing.
+type PoolSummary struct {
+	PoolID string `json:"pool_id"`
+	Hex    string `json:"hex"`
+	// Epoch is the retirement epoch of retired and retiring pools.
+	Epoch int `json:"epoch"`
+}
+
+// Pool describes a stake pool.
+type Pool struct {
+	PoolID         string   `json:"pool_id"`
+	Hex            string   `json:"hex"`
+	VrfKey         string   `json:"vrf_key"`
+	BlocksMinted   int      `json:"blocks_minted"`
+	LiveStake      Lovelace `json:"live_stake"`
+	LiveSize       float64  `json:"live_size"`
+	LiveSaturation float64  `json:"live_saturation"`
+	LiveDelegators int      `json:"live_delegators"`
+	ActiveStake    Lovelace `json:"active_stake"`
+	ActiveSize     float64  `json:"active_size"`
+	DeclaredPledge Lovelace `json:"declared_pledge"`
+	LivePledge     Lovelace `json:"live_pledge"`
+	Margin         float64  `json:"margin"`
+	FixedCost      Lovelace `json:"fixed_cost"`
+	RewardAccount  string   `json:"reward_account"`
+	Owners         []string `json:"owners"`
+	// Registration and Retirement list the hashes of the transactions
+	// registering and retiring the pool.
+	Registration []string `json:"registration"`
+	Retirement   []string `json:"retirement"`
+}
+
+// PoolMetadataLink points to the off-chain metadata of a pool.
+type PoolMetadataLink struct {
+	URL  string `json:"url"`
+	Hash string `json:"hash"`
+}
+
+// PoolMetadata is the off-chain metadata of a stake pool.
+type PoolMetadata struct {
+	PoolMetadataLink
+
+	Name        string `json:"name"`
+	Ticker      string `json:"ticker"`
+	Description string `json:"description"`
+	Homepage    string `json:"homepage"`
+	// Extended is the URL of the extended metadata, if any.
+	Extended string `json:"extended"`
+}
+
+// PoolRelay is a relay announced by a pool.
+type PoolRelay struct {
+	IPv4   string `json:"ipv4"`
+	IPv6   string `json:"ipv6"`
+	DNS    string `json:"dns"`
+	DNSSrv string `json:"dns_srv"`
+	Port   int    `json:"port"`
+}
+
+// PoolDelegator is a stake account delegating to a pool.
+type PoolDelegator struct {
+	Address   string   `json:"address"`
+	LiveStake Lovelace `json:"live_stake"`
+}
+
+// PoolHistory is the performance of a pool in an epoch.
+type PoolHistory struct {
+	Epoch           int      `json:"epoch"`
+	Blocks          int      `json:"blocks"`
+	ActiveStake     Lovelace `json:"active_stake"`
+	ActiveSize      float64  `json:"active_size"`
+	DelegatorsCount int      `json:"delegators_count"`
+	Rewards         Lovelace `json:"rewards"`
+	Fees            Lovelace `json:"fees"`
+}
+
+// Pool certificate actions.
+const (
+	PoolActionRegistered   = "registered"
+	PoolActionDeregistered = "deregistered"
+)
+
+// PoolCertificate is a registration, update or retirement certificate of a
+// pool.
+type PoolCertificate struct {
+	TxHash    string `json:"tx_hash"`
+	CertIndex int    `json:"cert_index"`
+	Action    string `json:"action"`
+}
+
+// Pools returns a page of the stake pools with the given status.
+func (c *apiClient) Pools(ctx context.Context, status PoolStatus, opts PageOptions) (Page[PoolSummary], error) {
+	if status == PoolsAll {
+		return getPage[PoolSummary](ctx, c, opts, nil, resourcePools)
+	}
+	return getPage[PoolSummary](ctx, c, opts, nil, resourcePools, string(status))
+}
+
+// Pool returns the stake pool with the given bech32 or hex ID.
+func (c *apiClient) Pool(ctx context.Context, poolID string) (pool Pool, err error) {
+	err = c.getJSON(ctx, nil, &pool, resourcePools, poolID)
+	return pool, err
+}
+
+// PoolMetadata returns the off-chain metadata of a stake pool. The document
+// is downloaded from the registered http or https URL with
+// APIClientOptions.MetadataHTTPClient, and its blake2b-256 hash checked
+// against the registered hash; ErrPoolMetadataHashMismatch is returned when
+// they differ.
+func (c *apiClient) PoolMetadata(ctx context.Context, poolID string) (metadata PoolMetadata, err error) {
+	var link PoolMetadataLink
+	if err = c.getJSON(ctx, nil, &link, resourcePools, poolID, resourceMetadata); err != nil {
+		return
+	}
+	if link.URL == "" {
+		return metadata, fmt.Errorf("pool %s has no metadata", poolID)
+	}
+
+	u, err := url.Parse(link.URL)
+	if err != nil {
+		return metadata, fmt.Errorf("pool metadata: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return metadata, fmt.Errorf("pool metadata: unsupported URL scheme %q", u.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return
+	}
+
+	// The URL is chosen by the pool operator: never send it through the
+	// API transport, which may carry credentials or certificates.
+	resp, err := c.metadataClient.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	if !isSuccess(resp.StatusCode) {
+		return metadata, fmt.Errorf("pool metadata: GET %s: %s", link.URL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPoolMetadataSize+1))
+	if err != nil {
+		return
+	}
+	if len(body) > maxPoolMetadataSize {
+		return metadata, fmt.Errorf("pool metadata: document exceeds %d bytes", maxPoolMetadataSize)
+	}
+
+	sum := blake2b.Sum256(body)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), link.Hash) {
+		return metadata, fmt.Errorf("%w: registered %s, computed %x", ErrPoolMetadataHashMismatch, link.Hash, sum)
+	}
+
+	if err = json.Unmarshal(body, &metadata); err != nil {
+		return metadata, fmt.Errorf("pool metadata: %w", err)
+	}
+	metadata.PoolMetadataLink = link
+
+	return metadata, nil
+}
+
+// PoolRelays returns the relays announced by a stake pool.
+func (c *apiClient) PoolRelays(ctx context.Context, poolID string) (relays []PoolRelay, err error) {
+	err = c.getJSON(ctx, nil, &relays, resourcePools, poolID, resourceRelays)
+	return relays, err
+}
+
+// PoolDelegators returns a page of the stake accounts delegating to a pool.
+func (c *apiClient) PoolDelegators(ctx context.Context, poolID string, opts PageOptions) (Page[PoolDelegator], error) {
+	return getPage[PoolDelegator](ctx, c, opts, nil, resourcePools, poolID, resourceDelegators)
+}
+
+// PoolBlocks returns a page of the blocks minted by a stake pool.
+func (c *apiClient) PoolBlocks(ctx context.Context, poolID string, opts PageOptions) (Page[Block], error) {
+	return getPage[Block](ctx, c, opts, nil, resourcePools, poolID, blocksResource)
+}
+
+// PoolHistory returns a page of the per-epoch performance of a stake pool.
+func (c *apiClient) PoolHistory(ctx context.Context, poolID string, opts PageOptions) (Page[PoolHistory], error) {
+	return getPage[PoolHistory](ctx, c, opts, nil, resourcePools, poolID, resourceHistory)
+}
+
+// PoolUpdates returns a page of the certificates registering, updating and
+// retiring a stake pool.
+func (c *apiClient) PoolUpdates(ctx context.Context, poolID string, opts PageOptions) (Page[PoolCertificate], error) {
+	return getPage[PoolCertificate](ctx, c, opts, nil, resourcePools, poolID, resourceUpdates)
+}
+
+// newMetadataClient returns the doer used to download off-chain metadata. It
+// shares no TLS configuration or custom client with the API transport, but
+// still honours the proxy from the environment.
+func newMetadataClient(options APIClientOptions) HttpRequestDoer {
+	if options.MetadataHTTPClient != nil {
+		return options.MetadataHTTPClient
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	return &http.Client{
+		Transport: transport,
+		Timeout:   defaultMetadataTimeout,
+	}
+}
@@ -0,0 +1,88 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// recordingDoer is an API client that records the hosts it is sent to.
+type recordingDoer struct {
+	hosts []string
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.hosts = append(d.hosts, req.URL.Host)
+	req.Header.Set("X-Secret", "api-credential")
+	return http.DefaultClient.Do(req)
+}
+
+func TestPoolMetadata(t *testing.T) {
+	const document = `{"name":"Stake Pool","ticker":"POOL","description":"A pool","homepage":"https://pool.example"}`
+	sum := blake2b.Sum256([]byte(document))
+
+	metadataServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Secret") != "" || r.Header.Get("X-Api-Key") != "" {
+			t.Errorf("metadata request carries API credentials: %v", r.Header)
+		}
+		switch r.URL.Path {
+		case "/large.json":
+			w.Write([]byte(strings.Repeat(" ", maxPoolMetadataSize) + document))
+		default:
+			w.Write([]byte(document))
+		}
+	}))
+	defer metadataServer.Close()
+
+	links := map[string]PoolMetadataLink{
+		"ok":       {URL: metadataServer.URL + "/pool.json", Hash: hex.EncodeToString(sum[:])},
+		"mismatch": {URL: metadataServer.URL + "/pool.json", Hash: strings.Repeat("00", 32)},
+		"large":    {URL: metadataServer.URL + "/large.json", Hash: hex.EncodeToString(sum[:])},
+		"file":     {URL: "file:///etc/passwd", Hash: hex.EncodeToString(sum[:])},
+		"ftp":      {URL: "ftp://" + strings.TrimPrefix(metadataServer.URL, "http://"), Hash: hex.EncodeToString(sum[:])},
+	}
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		// /app/v1/pools/<id>/metadata
+		if len(parts) != 6 || parts[3] != resourcePools || parts[5] != resourceMetadata {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(links[parts[4]])
+	}))
+	defer apiServer.Close()
+
+	doer := &recordingDoer{}
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: apiServer.URL, HTTPClient: doer})
+	ctx := context.Background()
+
+	metadata, err := client.PoolMetadata(ctx, "ok")
+	if err != nil {
+		t.Fatalf("PoolMetadata: %v", err)
+	}
+	if metadata.Name != "Stake Pool" || metadata.Ticker != "POOL" || metadata.URL != links["ok"].URL {
+		t.Errorf("PoolMetadata = %+v", metadata)
+	}
+	apiHost := strings.TrimPrefix(apiServer.URL, "http://")
+	for _, host := range doer.hosts {
+		if host != apiHost {
+			t.Errorf("API client sent a request to %s", host)
+		}
+	}
+
+	if _, err := client.PoolMetadata(ctx, "mismatch"); !errors.Is(err, ErrPoolMetadataHashMismatch) {
+		t.Errorf("PoolMetadata with wrong hash = %v, want ErrPoolMetadataHashMismatch", err)
+	}
+	for _, id := range []string{"large", "file", "ftp"} {
+		if _, err := client.PoolMetadata(ctx, id); err == nil || errors.Is(err, ErrPoolMetadataHashMismatch) {
+			t.Errorf("PoolMetadata(%s) = %v, want rejection", id, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package tangocrypto_go
+
+import "context"
+
+const (
+	resourceDatums  = "datums"
+	resourceScripts = "scripts"
+	resourceCBOR    = "cbor"
+	resourceJSON    = "json"
+)
+
+// ScriptRedeemer describes an execution of a script.
+type ScriptRedeemer struct {
+	TxHash    string   `json:"tx_hash"`
+	TxIndex   int      `json:"tx_index"`
+	Purpose   string   `json:"purpose"`
+	DatumHash string   `json:"datum_hash"`
+	UnitMem   int64    `json:"unit_mem"`
+	UnitSteps int64    `json:"unit_steps"`
+	Fee       Lovelace `json:"fee"`
+}
+
+// DatumByHash returns the datum with the given hash.
+func (c *apiClient) DatumByHash(ctx context.Context, hash string) (datum InlineDatum, err error) {
+	err = c.getJSON(ctx, nil, &datum, resourceDatums, hash)
+	return datum, err
+}
+
+// ScriptByHash returns the script with the given hash.
+func (c *apiClient) ScriptByHash(ctx context.Context, hash string) (script Script, err error) {
+	err = c.getJSON(ctx, nil, &script, resourceScripts, hash)
+	return script, err
+}
+
+// ScriptCBOR returns the hex encoded CBOR of the script with the given hash.
+func (c *apiClient) ScriptCBOR(ctx context.Context, hash string) (string, error) {
+	var v struct {
+		CBOR string `json:"cbor"`
+	}
+	err := c.getJSON(ctx, nil, &v, resourceScripts, hash, resourceCBOR)
+	return v.CBOR, err
+}
+
+// ScriptJSON returns the native script with the given hash.
+func (c *apiClient) ScriptJSON(ctx context.Context, hash string) (NativeScript, error) {
+	var v struct {
+		JSON NativeScript `json:"json"`
+	}
+	err := c.getJSON(ctx, nil, &v, resourceScripts, hash, resourceJSON)
+	return v.JSON, err
+}
+
+// ScriptRedeemers returns a page of the executions of the script with the
+// given hash.
+func (c *apiClient) ScriptRedeemers(ctx context.Context, hash string, opts PageOptions) (Page[ScriptRedeemer], error) {
+	return getPage[ScriptRedeemer](ctx, c, opts, nil, resourceScripts, hash, resourceRedeemers)
+}
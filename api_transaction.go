@@ -5,68 +5,192 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 )
 
 const (
-	resourceSubmit = "submit"
+	resourceSubmit      = "submit"
+	resourceMetadata    = "metadata"
+	resourceRedeemers   = "redeemers"
+	resourceWithdrawals = "withdrawals"
+	resourceDelegations = "delegations"
+	resourceStakes      = "stakes"
+	resourcePoolUpdates = "pool_updates"
+	resourceMints       = "mints"
 )
 
+// TransactionContent describes a transaction.
 type TransactionContent struct {
-	Hash                 string      `json:"hash"`
-	BlockID              string      `json:"block_id"`
-	BlockIndex           int         `json:"block_index"`
-	OutSum               string      `json:"out_sum"`
-	Fee                  string      `json:"fee"`
-	Deposit              string      `json:"deposit"`
-	Size                 int         `json:"size"`
-	InvalidBefore        interface{} `json:"invalid_before"`
-	InvalidHereafter     string      `json:"invalid_hereafter"`
-	ValidContract        bool        `json:"valid_contract"`
-	ScriptSize           int         `json:"script_size"`
-	UtxoCount            string      `json:"utxo_count"`
-	WithdrawalCount      string      `json:"withdrawal_count"`
-	DelegationCount      string      `json:"delegation_count"`
-	StakeCertCount       string      `json:"stake_cert_count"`
-	PoolUpdate           bool        `json:"pool_update"`
-	PoolRetire           bool        `json:"pool_retire"`
-	AssetMintOrBurnCount string      `json:"asset_mint_or_burn_count"`
-	Block                Block       `json:"block"`
-	Assets               []Assets    `json:"assets"`
-}
-
-type Block struct {
-	Hash    string `json:"hash"`
-	EpochNo int    `json:"epoch_no"`
-	BlockNo int    `json:"block_no"`
-	SlotNo  int    `json:"slot_no"`
+	Hash                 string   `json:"hash"`
+	BlockID              string   `json:"block_id"`
+	BlockIndex           int      `json:"block_index"`
+	OutSum               Lovelace `json:"out_sum"`
+	Fee                  Lovelace `json:"fee"`
+	Deposit              Int64    `json:"deposit"`
+	Size                 int      `json:"size"`
+	InvalidBefore        NullSlot `json:"invalid_before"`
+	InvalidHereafter     NullSlot `json:"invalid_hereafter"`
+	ValidContract        bool     `json:"valid_contract"`
+	ScriptSize           int      `json:"script_size"`
+	UtxoCount            Int64    `json:"utxo_count"`
+	WithdrawalCount      Int64    `json:"withdrawal_count"`
+	DelegationCount      Int64    `json:"delegation_count"`
+	StakeCertCount       Int64    `json:"stake_cert_count"`
+	PoolUpdate           bool     `json:"pool_update"`
+	PoolRetire           bool     `json:"pool_retire"`
+	AssetMintOrBurnCount Int64    `json:"asset_mint_or_burn_count"`
+	Block                Block    `json:"block"`
+	Assets               []Assets `json:"assets"`
 }
 
+// TransactionUTXOs lists the inputs and outputs of a transaction.
+type TransactionUTXOs struct {
+	Hash    string            `json:"hash"`
+	Inputs  []TransactionUTXO `json:"inputs"`
+	Outputs []TransactionUTXO `json:"outputs"`
+}
+
+// TransactionUTXO is an input or an output of a transaction. For inputs,
+// Hash and Index identify the output being spent.
+type TransactionUTXO struct {
+	Address     string      `json:"address"`
+	Hash        string      `json:"hash"`
+	Index       int         `json:"index"`
+	Value       Lovelace    `json:"value"`
+	Assets      []Assets    `json:"assets"`
+	Collateral  bool        `json:"collateral"`
+	Reference   bool        `json:"reference"`
+	DatumHash   string      `json:"datum_hash"`
+	InlineDatum InlineDatum `json:"inline_datum"`
+	Script      Script      `json:"script"`
+}
+
+// TransactionMetadata is a metadata entry of a transaction. JSON holds the
+// metadata value as returned by the API.
+type TransactionMetadata struct {
+	Label string          `json:"label"`
+	JSON  json.RawMessage `json:"json"`
+}
+
+// Redeemer describes a script execution of a transaction.
+type Redeemer struct {
+	Index      int      `json:"index"`
+	Purpose    string   `json:"purpose"`
+	ScriptHash string   `json:"script_hash"`
+	DatumHash  string   `json:"datum_hash"`
+	UnitMem    int64    `json:"unit_mem"`
+	UnitSteps  int64    `json:"unit_steps"`
+	Fee        Lovelace `json:"fee"`
+}
+
+// Withdrawal is a reward withdrawal made by a transaction.
+type Withdrawal struct {
+	Address string   `json:"address"`
+	Amount  Lovelace `json:"amount"`
+}
+
+// Delegation is a delegation certificate of a transaction.
+type Delegation struct {
+	Index       int    `json:"index"`
+	Address     string `json:"address"`
+	PoolID      string `json:"pool_id"`
+	ActiveEpoch int    `json:"active_epoch"`
+}
+
+// StakeCertificate is a stake key registration or deregistration
+// certificate of a transaction.
+type StakeCertificate struct {
+	Index        int    `json:"index"`
+	Address      string `json:"address"`
+	Registration bool   `json:"registration"`
+}
+
+// PoolUpdate is a pool registration or update certificate of a transaction.
+type PoolUpdate struct {
+	Index         int              `json:"index"`
+	PoolID        string           `json:"pool_id"`
+	VrfKey        string           `json:"vrf_key"`
+	Pledge        Lovelace         `json:"pledge"`
+	Margin        float64          `json:"margin"`
+	FixedCost     Lovelace         `json:"fixed_cost"`
+	RewardAccount string           `json:"reward_account"`
+	Owners        []string         `json:"owners"`
+	Metadata      PoolMetadataLink `json:"metadata"`
+	Relays        []PoolRelay      `json:"relays"`
+	ActiveEpoch   int              `json:"active_epoch"`
+}
+
+// AssetMint is a mint, or a burn when Quantity is negative, of a native
+// asset by a transaction.
+type AssetMint struct {
+	PolicyID    string   `json:"policy_id"`
+	AssetName   string   `json:"asset_name"`
+	Fingerprint string   `json:"fingerprint"`
+	Quantity    Quantity `json:"quantity"`
+}
+
+// Transaction returns the content of the transaction with the given hash.
 func (c *apiClient) Transaction(ctx context.Context, hash string) (content TransactionContent, err error) {
-	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s", c.server, c.appID, resourceTransactions, hash))
-	if err != nil {
-		return
-	}
+	err = c.getJSON(ctx, nil, &content, resourceTransactions, hash)
+	return content, err
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
-	if err != nil {
-		return
-	}
+// TransactionUTXOs returns the inputs and outputs of a transaction.
+func (c *apiClient) TransactionUTXOs(ctx context.Context, hash string) (utxos TransactionUTXOs, err error) {
+	err = c.getJSON(ctx, nil, &utxos, resourceTransactions, hash, resourceUTXOs)
+	return utxos, err
+}
 
-	resp, err := c.handleRequest(req)
-	if err != nil {
-		return
-	}
-	defer resp.Body.Close()
+// TransactionMetadata returns the metadata attached to a transaction.
+func (c *apiClient) TransactionMetadata(ctx context.Context, hash string) (metadata []TransactionMetadata, err error) {
+	err = c.getJSON(ctx, nil, &metadata, resourceTransactions, hash, resourceMetadata)
+	return metadata, err
+}
 
-	if err = json.NewDecoder(resp.Body).Decode(&content); err != nil {
-		return
-	}
+// TransactionRedeemers returns the redeemers of a transaction.
+func (c *apiClient) TransactionRedeemers(ctx context.Context, hash string) (redeemers []Redeemer, err error) {
+	err = c.getJSON(ctx, nil, &redeemers, resourceTransactions, hash, resourceRedeemers)
+	return redeemers, err
+}
+
+// TransactionWithdrawals returns the reward withdrawals of a transaction.
+func (c *apiClient) TransactionWithdrawals(ctx context.Context, hash string) (withdrawals []Withdrawal, err error) {
+	err = c.getJSON(ctx, nil, &withdrawals, resourceTransactions, hash, resourceWithdrawals)
+	return withdrawals, err
+}
 
-	return content, nil
+// TransactionDelegations returns the delegation certificates of a
+// transaction.
+func (c *apiClient) TransactionDelegations(ctx context.Context, hash string) (delegations []Delegation, err error) {
+	err = c.getJSON(ctx, nil, &delegations, resourceTransactions, hash, resourceDelegations)
+	return delegations, err
 }
 
+// TransactionStakeCertificates returns the stake key registration and
+// deregistration certificates of a transaction.
+func (c *apiClient) TransactionStakeCertificates(ctx context.Context, hash string) (certs []StakeCertificate, err error) {
+	err = c.getJSON(ctx, nil, &certs, resourceTransactions, hash, resourceStakes)
+	return certs, err
+}
+
+// TransactionPoolUpdates returns the pool registration and update
+// certificates of a transaction.
+func (c *apiClient) TransactionPoolUpdates(ctx context.Context, hash string) (updates []PoolUpdate, err error) {
+	err = c.getJSON(ctx, nil, &updates, resourceTransactions, hash, resourcePoolUpdates)
+	return updates, err
+}
+
+// TransactionMints returns the assets minted or burnt by a transaction.
+func (c *apiClient) TransactionMints(ctx context.Context, hash string) (mints []AssetMint, err error) {
+	err = c.getJSON(ctx, nil, &mints, resourceTransactions, hash, resourceMints)
+	return mints, err
+}
+
+// TransactionSubmit submits a signed transaction in CBOR format and returns
+// its hash. The hash is empty when the API acknowledges the submission
+// without content.
 func (c *apiClient) TransactionSubmit(ctx context.Context, cbor []byte) (hash string, err error) {
 	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s/%s", c.server, c.appID, resourceTransactions, resourceSubmit))
 	if err != nil {
@@ -84,9 +208,39 @@ func (c *apiClient) TransactionSubmit(ctx context.Context, cbor []byte) (hash st
 	}
 	defer resp.Body.Close()
 
-	if err = json.NewDecoder(resp.Body).Decode(&hash); err != nil {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
 		return
 	}
 
-	return hash, nil
+	return decodeSubmitResponse(body)
+}
+
+// decodeSubmitResponse extracts the transaction hash from a submit response,
+// given either as a JSON string, a JSON object or plain text.
+func decodeSubmitResponse(body []byte) (hash string, err error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return "", nil
+	}
+
+	switch body[0] {
+	case '"':
+		err = json.Unmarshal(body, &hash)
+		return hash, err
+	case '{':
+		var v struct {
+			Hash string `json:"hash"`
+			TxID string `json:"tx_id"`
+		}
+		if err = json.Unmarshal(body, &v); err != nil {
+			return "", err
+		}
+		if v.Hash != "" {
+			return v.Hash, nil
+		}
+		return v.TxID, nil
+	default:
+		return string(body), nil
+	}
 }
@@ -0,0 +1,36 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransactionPath(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		w.Write([]byte(`{"hash":"abcd"}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+	ctx := context.Background()
+
+	tx, err := client.Transaction(ctx, "abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Hash != "abcd" {
+		t.Errorf("Transaction = %+v", tx)
+	}
+
+	if _, err := client.Transaction(ctx, "ab/../cd?x"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"/app/v1/transactions/abcd", "/app/v1/transactions/ab%2F..%2Fcd%3Fx"}
+	if len(paths) != 2 || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("requested %v, want %v", paths, want)
+	}
+}
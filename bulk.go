@@ -0,0 +1,108 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// defaultBulkConcurrency is the number of requests bulk queries run at once
+// when APIClientOptions.BulkConcurrency is not set.
+const defaultBulkConcurrency = 8
+
+// AddressSummaryResult is the outcome of the lookup of one address by
+// AddressesSummary.
+type AddressSummaryResult struct {
+	Address string
+	Summary AddressSummary
+	Err     error
+}
+
+// AddressUTXOsResult is the outcome of the lookup of one address by
+// AddressesUTXOs.
+type AddressUTXOsResult struct {
+	Address string
+	UTXOs   []Data
+	Err     error
+}
+
+// AddressesSummary returns the summary of each address, in the order of
+// addresses. A failed lookup sets the Err of its result and does not stop the
+// others. The returned error is the context error when ctx ended before every
+// lookup completed, in which case the unfinished lookups carry it too.
+//
+// Lookups are always issued one request per address, by a bounded pool of
+// workers, see APIClientOptions.BulkConcurrency; no batch endpoint is used.
+func (c *apiClient) AddressesSummary(ctx context.Context, addresses []string) ([]AddressSummaryResult, error) {
+	results := make([]AddressSummaryResult, len(addresses))
+	c.bulk(ctx, len(addresses), func(ctx context.Context, i int) {
+		summary, err := c.AddressSummary(ctx, addresses[i])
+		results[i] = AddressSummaryResult{Address: addresses[i], Summary: summary, Err: err}
+	})
+	return results, bulkErr(ctx, len(results), func(i int) error { return results[i].Err })
+}
+
+// AddressesUTXOs returns every UTxO held by each address, in the order of
+// addresses, following the pagination of each one. Failures are reported per
+// address as in AddressesSummary.
+func (c *apiClient) AddressesUTXOs(ctx context.Context, addresses []string) ([]AddressUTXOsResult, error) {
+	results := make([]AddressUTXOsResult, len(addresses))
+	c.bulk(ctx, len(addresses), func(ctx context.Context, i int) {
+		result := AddressUTXOsResult{Address: addresses[i]}
+		for utxo, err := range NewUTXOIterator(c, addresses[i], PageOptions{}).All(ctx) {
+			if err != nil {
+				result.UTXOs, result.Err = nil, err
+				break
+			}
+			result.UTXOs = append(result.UTXOs, utxo)
+		}
+		results[i] = result
+	})
+	return results, bulkErr(ctx, len(results), func(i int) error { return results[i].Err })
+}
+
+// bulk calls fn for each index in [0, n) from at most c.concurrency
+// goroutines. Once ctx is done, the remaining calls fail fast with its error,
+// so every result is filled in.
+func (c *apiClient) bulk(ctx context.Context, n int, fn func(ctx context.Context, i int)) {
+	workers := c.concurrency
+	if workers <= 0 {
+		workers = defaultBulkConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(ctx, i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// bulkErr returns the context error when one of the n results failed with it,
+// and nil when every lookup completed before ctx ended.
+func bulkErr(ctx context.Context, n int, resultErr func(i int) error) error {
+	ctxErr := ctx.Err()
+	if ctxErr == nil {
+		return nil
+	}
+	for i := 0; i < n; i++ {
+		if errors.Is(resultErr(i), ctxErr) {
+			return ctxErr
+		}
+	}
+	return nil
+}
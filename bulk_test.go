@@ -0,0 +1,182 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var bulkAddresses = []string{
+	"addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+	"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+	"addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+	"addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k",
+	"addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+	"addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+}
+
+// bulkServer answers address summaries with the index of the address as its
+// balance, slower for the first addresses so that lookups complete out of
+// order. It fails the address at index failAt with a 404 and records the
+// highest number of requests in flight.
+func bulkServer(t *testing.T, failAt int, maxInFlight *atomic.Int32) *httptest.Server {
+	t.Helper()
+	var inFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			max := maxInFlight.Load()
+			if n <= max || maxInFlight.CompareAndSwap(max, n) {
+				break
+			}
+		}
+
+		for i, addr := range bulkAddresses {
+			if !strings.Contains(r.URL.Path, addr) {
+				continue
+			}
+			time.Sleep(time.Duration(len(bulkAddresses)-i) * 5 * time.Millisecond)
+			if i == failAt {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"not found"}`))
+				return
+			}
+			if strings.HasSuffix(r.URL.Path, "/utxos") {
+				fmt.Fprintf(w, `{"data":[{"hash":"%d","value":"%d"}],"cursor":null}`, i, i)
+				return
+			}
+			fmt.Fprintf(w, `{"address":%q,"balance":"%d"}`, addr, i)
+			return
+		}
+		t.Errorf("unexpected request %s", r.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAddressesSummary(t *testing.T) {
+	var maxInFlight atomic.Int32
+	server := bulkServer(t, 2, &maxInFlight)
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL, BulkConcurrency: 3})
+
+	addresses := append(append([]string{}, bulkAddresses...), "not an address")
+	results, err := client.AddressesSummary(context.Background(), addresses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(addresses) {
+		t.Fatalf("%d results, want %d", len(results), len(addresses))
+	}
+
+	for i, r := range results {
+		if r.Address != addresses[i] {
+			t.Errorf("result %d is for %s, want %s", i, r.Address, addresses[i])
+		}
+		switch {
+		case i == 2:
+			if !errors.Is(r.Err, ErrNotFound) {
+				t.Errorf("result %d: err = %v, want ErrNotFound", i, r.Err)
+			}
+		case i == len(bulkAddresses):
+			if r.Err == nil {
+				t.Errorf("invalid address: no error")
+			}
+		case r.Err != nil || r.Summary.Balance != Lovelace(i):
+			t.Errorf("result %d: balance %d, err %v", i, r.Summary.Balance, r.Err)
+		}
+	}
+
+	if max := maxInFlight.Load(); max > 3 || max < 2 {
+		t.Errorf("%d requests in flight, want at most 3", max)
+	}
+}
+
+func TestAddressesUTXOs(t *testing.T) {
+	var maxInFlight atomic.Int32
+	server := bulkServer(t, 4, &maxInFlight)
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL, BulkConcurrency: 1})
+
+	results, err := client.AddressesUTXOs(context.Background(), bulkAddresses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.Address != bulkAddresses[i] {
+			t.Errorf("result %d is for %s", i, r.Address)
+		}
+		if i == 4 {
+			if !errors.Is(r.Err, ErrNotFound) || r.UTXOs != nil {
+				t.Errorf("result %d: %d UTxOs, err = %v, want ErrNotFound", i, len(r.UTXOs), r.Err)
+			}
+			continue
+		}
+		if r.Err != nil || len(r.UTXOs) != 1 || r.UTXOs[0].Hash != fmt.Sprint(i) {
+			t.Errorf("result %d: %+v, err %v", i, r.UTXOs, r.Err)
+		}
+	}
+	if max := maxInFlight.Load(); max != 1 {
+		t.Errorf("%d requests in flight, want 1", max)
+	}
+}
+
+func TestAddressesSummaryCancelled(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL, BulkConcurrency: 2})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	results, err := client.AddressesSummary(ctx, bulkAddresses)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("returned after %s", elapsed)
+	}
+	if len(results) != len(bulkAddresses) {
+		t.Fatalf("%d results, want %d", len(results), len(bulkAddresses))
+	}
+	for i, r := range results {
+		if r.Address != bulkAddresses[i] || !errors.Is(r.Err, context.DeadlineExceeded) {
+			t.Errorf("result %d: %s, err %v", i, r.Address, r.Err)
+		}
+	}
+	if calls.Load() > 2 {
+		t.Errorf("%d requests sent after the deadline, want at most 2", calls.Load())
+	}
+}
+
+func TestBulkErr(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs := []error{nil, ErrNotFound}
+	if err := bulkErr(ctx, len(errs), func(i int) error { return errs[i] }); err != nil {
+		t.Errorf("every lookup completed: err = %v, want nil", err)
+	}
+	errs[0] = fmt.Errorf("get: %w", context.Canceled)
+	if err := bulkErr(ctx, len(errs), func(i int) error { return errs[i] }); !errors.Is(err, context.Canceled) {
+		t.Errorf("a lookup was cancelled: err = %v, want context.Canceled", err)
+	}
+	if err := bulkErr(context.Background(), len(errs), func(i int) error { return errs[i] }); err != nil {
+		t.Errorf("live context: err = %v, want nil", err)
+	}
+}
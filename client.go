@@ -2,14 +2,22 @@ package tangocrypto_go
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 type apiClient struct {
-	server string
-	appID  string
-	apiKey string
-	client *http.Client
+	server  string
+	appID   string
+	apiKey  string
+	client  HttpRequestDoer
+	retry   *RetryPolicy
+	limiter *limiter
+
+	concurrency    int
+	metadataClient HttpRequestDoer
 }
 
 // HttpRequestDoer defines methods for a http client.
@@ -25,33 +33,153 @@ type APIClientOptions struct {
 
 	// Server url to use
 	Server string
+
+	// HTTPClient is used to perform requests. When set, Timeout, Proxy,
+	// TLSConfig and Transport are ignored.
+	HTTPClient HttpRequestDoer
+
+	// Timeout limits the time spent on a single request, including reading
+	// the response body. Zero means no timeout.
+	Timeout time.Duration
+
+	// Proxy selects the proxy for a request, see http.Transport.Proxy. When
+	// nil, the proxy is taken from the environment.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// TLSConfig is the TLS configuration used by the default transport, e.g.
+	// to provide client certificates for mTLS.
+	TLSConfig *tls.Config
+
+	// Transport is the round tripper used by the client. When set, Proxy and
+	// TLSConfig are ignored.
+	Transport http.RoundTripper
+
+	// Retry enables automatic retries of transient failures. Requests are
+	// not retried when nil, see DefaultRetryPolicy.
+	Retry *RetryPolicy
+
+	// RateLimit throttles requests on the client side so that goroutines
+	// sharing the client stay within the app quota together. Requests are
+	// not throttled when nil.
+	RateLimit *RateLimit
+
+	// BulkConcurrency is the number of requests AddressesSummary and
+	// AddressesUTXOs run at once. Defaults to 8.
+	BulkConcurrency int
+
+	// MetadataHTTPClient downloads off-chain metadata from URLs registered on
+	// chain, such as pool metadata. It is never the API client, so that the
+	// owner of such a URL cannot receive the credentials or certificates
+	// configured for the API. Defaults to a plain client using the proxy from
+	// the environment.
+	MetadataHTTPClient HttpRequestDoer
 }
 
 // NewAPICLient creates a client from APIClientOptions. If no options are provided,
-//  client with default configurations is returned.
+// client with default configurations is returned.
 func NewAPIClient(options APIClientOptions) APIClient {
 	if options.Server == "" {
 		options.Server = CardanoMainNet
 	}
 
-	c := &http.Client{}
-
 	client := &apiClient{
-		server: options.Server,
-		client: c,
-		appID:  options.AppID,
-		apiKey: options.ApiKey,
+		server:  options.Server,
+		client:  newHTTPClient(options),
+		appID:   options.AppID,
+		apiKey:  options.ApiKey,
+		retry:   options.Retry,
+		limiter: newLimiter(options.RateLimit),
+
+		concurrency:    options.BulkConcurrency,
+		metadataClient: newMetadataClient(options),
 	}
 
 	return client
 }
 
+// newHTTPClient returns the doer configured by options.
+func newHTTPClient(options APIClientOptions) HttpRequestDoer {
+	if options.HTTPClient != nil {
+		return options.HTTPClient
+	}
+
+	transport := options.Transport
+	if transport == nil && (options.Proxy != nil || options.TLSConfig != nil) {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		if options.Proxy != nil {
+			t.Proxy = options.Proxy
+		}
+		if options.TLSConfig != nil {
+			t.TLSClientConfig = options.TLSConfig
+		}
+		transport = t
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   options.Timeout,
+	}
+}
+
 // APIClient defines methods implemented by the api client.
 type APIClient interface {
 	AddressSummary(ctx context.Context, address string) (AddressSummary, error)
 	AddressUTXOs(ctx context.Context, address string) (AddrUTXOs, error)
+	AddressUTXOsPage(ctx context.Context, address string, opts PageOptions) (AddrUTXOs, error)
+	AddressTransactions(ctx context.Context, address string, opts AddressTransactionsOptions) (Page[AddressTransaction], error)
+	AddressTotal(ctx context.Context, address string) (AddressTotal, error)
+	AddressesSummary(ctx context.Context, addresses []string) ([]AddressSummaryResult, error)
+	AddressesUTXOs(ctx context.Context, addresses []string) ([]AddressUTXOsResult, error)
+	Transaction(ctx context.Context, hash string) (TransactionContent, error)
+	TransactionUTXOs(ctx context.Context, hash string) (TransactionUTXOs, error)
+	TransactionMetadata(ctx context.Context, hash string) ([]TransactionMetadata, error)
+	TransactionRedeemers(ctx context.Context, hash string) ([]Redeemer, error)
+	TransactionWithdrawals(ctx context.Context, hash string) ([]Withdrawal, error)
+	TransactionDelegations(ctx context.Context, hash string) ([]Delegation, error)
+	TransactionStakeCertificates(ctx context.Context, hash string) ([]StakeCertificate, error)
+	TransactionPoolUpdates(ctx context.Context, hash string) ([]PoolUpdate, error)
+	TransactionMints(ctx context.Context, hash string) ([]AssetMint, error)
 	TransactionSubmit(ctx context.Context, cbor []byte) (string, error)
-	ProtocolParameters(ctx context.Context, epochNumber string) (EpochParameters, error)
+	DatumByHash(ctx context.Context, hash string) (InlineDatum, error)
+	ScriptByHash(ctx context.Context, hash string) (Script, error)
+	ScriptCBOR(ctx context.Context, hash string) (string, error)
+	ScriptJSON(ctx context.Context, hash string) (NativeScript, error)
+	ScriptRedeemers(ctx context.Context, hash string, opts PageOptions) (Page[ScriptRedeemer], error)
+	Asset(ctx context.Context, policyID, assetName string) (AssetInfo, error)
+	AssetByFingerprint(ctx context.Context, fingerprint string) (AssetInfo, error)
+	AssetAddresses(ctx context.Context, policyID, assetName string, opts PageOptions) (Page[AssetHolder], error)
+	AssetTransactions(ctx context.Context, policyID, assetName string, opts PageOptions) (Page[AssetTransaction], error)
+	AssetHistory(ctx context.Context, policyID, assetName string, opts PageOptions) (Page[AssetHistoryEntry], error)
+	PolicyAssets(ctx context.Context, policyID string, opts PageOptions) (Page[Assets], error)
+	StakeAccount(ctx context.Context, stakeAddress string) (StakeAccount, error)
+	StakeAccountAddresses(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountAddress], error)
+	StakeAccountRewards(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountReward], error)
+	StakeAccountHistory(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountHistory], error)
+	StakeAccountDelegations(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountDelegation], error)
+	StakeAccountRegistrations(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountRegistration], error)
+	StakeAccountWithdrawals(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountWithdrawal], error)
+	StakeAccountMIRs(ctx context.Context, stakeAddress string, opts PageOptions) (Page[StakeAccountMIR], error)
+	Pools(ctx context.Context, status PoolStatus, opts PageOptions) (Page[PoolSummary], error)
+	Pool(ctx context.Context, poolID string) (Pool, error)
+	PoolMetadata(ctx context.Context, poolID string) (PoolMetadata, error)
+	PoolRelays(ctx context.Context, poolID string) ([]PoolRelay, error)
+	PoolDelegators(ctx context.Context, poolID string, opts PageOptions) (Page[PoolDelegator], error)
+	PoolBlocks(ctx context.Context, poolID string, opts PageOptions) (Page[Block], error)
+	PoolHistory(ctx context.Context, poolID string, opts PageOptions) (Page[PoolHistory], error)
+	PoolUpdates(ctx context.Context, poolID string, opts PageOptions) (Page[PoolCertificate], error)
+	ProtocolParameters(ctx context.Context, epoch EpochNumber) (EpochParameters, error)
 	CurrentEpoch(ctx context.Context) (CurrentEpoch, error)
-	LatestBlock(ctx context.Context) (LatestBlock, error)
+	Epoch(ctx context.Context, number int) (Epoch, error)
+	EpochRange(ctx context.Context, from, to int, opts PageOptions) (Page[Epoch], error)
+	EpochStakeDistribution(ctx context.Context, number int, opts PageOptions) (Page[EpochStake], error)
+	EpochStakeByPool(ctx context.Context, number int, poolID string, opts PageOptions) (Page[EpochStake], error)
+	EpochBlocks(ctx context.Context, number int, opts PageOptions) (Page[Block], error)
+	EpochBlocksByPool(ctx context.Context, number int, poolID string, opts PageOptions) (Page[Block], error)
+	LatestBlock(ctx context.Context) (Block, error)
+	BlockByHash(ctx context.Context, hash string) (Block, error)
+	BlockByNumber(ctx context.Context, number int) (Block, error)
+	BlockBySlot(ctx context.Context, slot int) (Block, error)
+	NextBlocks(ctx context.Context, hashOrNumber string, opts PageOptions) (Page[Block], error)
+	PreviousBlocks(ctx context.Context, hashOrNumber string, opts PageOptions) (Page[Block], error)
+	BlockTransactions(ctx context.Context, hashOrNumber string, opts PageOptions) (Page[BlockTransaction], error)
 }
@@ -0,0 +1,89 @@
+package tangocrypto_go
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/ripoff2/tangocrypto-go/internal/bech32"
+)
+
+// assetFingerprintHRP is the bech32 prefix of CIP-14 asset fingerprints.
+const assetFingerprintHRP = "asset"
+
+// ErrFingerprintMismatch is returned by Assets.Verify when the fingerprint
+// returned by the API differs from the computed one.
+var ErrFingerprintMismatch = errors.New("tangocrypto: asset fingerprint mismatch")
+
+// AssetFingerprint computes the CIP-14 fingerprint of an asset, the bech32
+// encoded blake2b-160 hash of its policy ID and name, e.g.
+// "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3". policyID and assetName are
+// hex encoded.
+func AssetFingerprint(policyID, assetName string) (string, error) {
+	policy, err := hex.DecodeString(policyID)
+	if err != nil || len(policy) != 28 {
+		return "", fmt.Errorf("fingerprint: invalid policy id %q", policyID)
+	}
+	name, err := hex.DecodeString(assetName)
+	if err != nil || len(name) > 32 {
+		return "", fmt.Errorf("fingerprint: invalid asset name %q", assetName)
+	}
+
+	h, err := blake2b.New(20, nil)
+	if err != nil {
+		return "", err
+	}
+	h.Write(policy)
+	h.Write(name)
+
+	return bech32.Encode(assetFingerprintHRP, h.Sum(nil))
+}
+
+// ParseAssetFingerprint validates a CIP-14 fingerprint and returns the
+// 20 bytes hash it encodes, suitable as a compact lookup key.
+func ParseAssetFingerprint(fingerprint string) ([]byte, error) {
+	hrp, hash, err := bech32.Decode(fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("fingerprint: %w", err)
+	}
+	if hrp != assetFingerprintHRP || len(hash) != 20 {
+		return nil, fmt.Errorf("fingerprint: %q is not an asset fingerprint", fingerprint)
+	}
+	return hash, nil
+}
+
+// AssetUnit returns the unit of an asset, the concatenation of its hex
+// encoded policy ID and name, used as asset lookup key.
+func AssetUnit(policyID, assetName string) string {
+	return strings.ToLower(policyID + assetName)
+}
+
+// ParseAssetUnit splits an asset unit into its hex encoded policy ID and
+// asset name.
+func ParseAssetUnit(unit string) (policyID, assetName string, err error) {
+	if len(unit) < 56 || len(unit) > 56+64 || len(unit)%2 != 0 {
+		return "", "", fmt.Errorf("asset unit: invalid length %d", len(unit))
+	}
+	if _, err = hex.DecodeString(unit); err != nil {
+		return "", "", fmt.Errorf("asset unit: %w", err)
+	}
+	unit = strings.ToLower(unit)
+	return unit[:56], unit[56:], nil
+}
+
+// Verify checks the fingerprint returned by the API against the one computed
+// from the policy ID and asset name. It returns ErrFingerprintMismatch when
+// they differ.
+func (a Assets) Verify() error {
+	fp, err := AssetFingerprint(a.PolicyID, a.AssetName)
+	if err != nil {
+		return err
+	}
+	if fp != a.Fingerprint {
+		return fmt.Errorf("%w: expected %s, got %s", ErrFingerprintMismatch, fp, a.Fingerprint)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package tangocrypto_go
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ripoff2/tangocrypto-go/internal/bech32"
+)
+
+// CIP-14 test vectors.
+var fingerprintVectors = []struct {
+	policyID, assetName, fingerprint string
+}{
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "", "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc37e", "", "asset1nl0puwxmhas8fawxp8nx4e2q3wekg969n2auw3"},
+	{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "", "asset1uyuxku60yqe57nusqzjx38aan3f2wq6s93f6ea"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "504154415445", "asset13n25uv0yaf5kus35fm2k86cqy60z58d9xmde92"},
+	{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "504154415445", "asset1hv4p5tv2a837mzqrst04d0dcptdjmluqvdx9k3"},
+	{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "asset1aqrdypg669jgazruv5ah07nuyqe0wxjhe2el6f"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "asset17jd78wukhtrnmjh3fngzasxm8rck0l2r4hhyyt"},
+	{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "0000000000000000000000000000000000000000000000000000000000000000", "asset1pkpwyknlvul7az0xx8czhl60pyel45rpje4z8w"},
+}
+
+func TestAssetFingerprint(t *testing.T) {
+	for _, v := range fingerprintVectors {
+		got, err := AssetFingerprint(v.policyID, v.assetName)
+		if err != nil {
+			t.Fatalf("AssetFingerprint(%s, %q): %v", v.policyID, v.assetName, err)
+		}
+		if got != v.fingerprint {
+			t.Errorf("AssetFingerprint(%s, %q) = %s, want %s", v.policyID, v.assetName, got, v.fingerprint)
+		}
+
+		if _, err := ParseAssetFingerprint(v.fingerprint); err != nil {
+			t.Errorf("ParseAssetFingerprint(%s): %v", v.fingerprint, err)
+		}
+		if err := (Assets{PolicyID: v.policyID, AssetName: v.assetName, Fingerprint: v.fingerprint}).Verify(); err != nil {
+			t.Errorf("Verify(%s): %v", v.fingerprint, err)
+		}
+	}
+}
+
+func TestAssetFingerprintInvalid(t *testing.T) {
+	const policy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	for _, tt := range []struct{ policyID, assetName string }{
+		{"", ""},
+		{policy[:54], ""},
+		{policy + "00", ""},
+		{"zz" + policy[2:], ""},
+		{policy, "0"},
+		{policy, strings.Repeat("00", 33)},
+	} {
+		if fp, err := AssetFingerprint(tt.policyID, tt.assetName); err == nil {
+			t.Errorf("AssetFingerprint(%q, %q) = %s, want error", tt.policyID, tt.assetName, fp)
+		}
+	}
+
+	short, err := bech32.Encode("asset", make([]byte, 19))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongPrefix, err := bech32.Encode("addr", make([]byte, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fp := range []string{
+		"",
+		"asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc4", // checksum
+		wrongPrefix,
+		short,
+	} {
+		if _, err := ParseAssetFingerprint(fp); err == nil {
+			t.Errorf("ParseAssetFingerprint(%q) succeeded, want error", fp)
+		}
+	}
+
+	err = (Assets{PolicyID: policy, Fingerprint: "asset1nl0puwxmhas8fawxp8nx4e2q3wekg969n2auw3"}).Verify()
+	if !errors.Is(err, ErrFingerprintMismatch) {
+		t.Errorf("Verify = %v, want ErrFingerprintMismatch", err)
+	}
+}
+
+func TestAssetUnit(t *testing.T) {
+	const policy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+
+	unit := AssetUnit(strings.ToUpper(policy), "504154415445")
+	if unit != policy+"504154415445" {
+		t.Fatalf("AssetUnit = %s", unit)
+	}
+	p, n, err := ParseAssetUnit(unit)
+	if err != nil || p != policy || n != "504154415445" {
+		t.Fatalf("ParseAssetUnit = %s, %s, %v", p, n, err)
+	}
+	if p, n, err = ParseAssetUnit(policy); err != nil || p != policy || n != "" {
+		t.Fatalf("ParseAssetUnit(policy) = %s, %s, %v", p, n, err)
+	}
+
+	for _, u := range []string{"", policy[:54], policy + "0", policy + strings.Repeat("00", 33), "zz" + policy[2:]} {
+		if _, _, err := ParseAssetUnit(u); err == nil {
+			t.Errorf("ParseAssetUnit(%q) succeeded, want error", u)
+		}
+	}
+}
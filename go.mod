@@ -0,0 +1,7 @@
+module github.com/ripoff2/tangocrypto-go
+
+go 1.23.0
+
+require golang.org/x/crypto v0.40.0
+
+require golang.org/x/sys v0.34.0 // indirect
@@ -0,0 +1,129 @@
+package bech32
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeValid(t *testing.T) {
+	tests := []struct {
+		s    string
+		hrp  string
+		data []byte
+	}{
+		// BIP-173 vectors without data payload.
+		{"A12UEL5L", "a", []byte{}},
+		{"a12uel5l", "a", []byte{}},
+		// CIP-14 and CIP-19 strings.
+		{"asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3", "asset", nil},
+		{"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw", "stake", nil},
+		{"addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz", "addr_test", nil},
+	}
+	for _, tt := range tests {
+		hrp, data, err := Decode(tt.s)
+		if err != nil {
+			t.Errorf("Decode(%s): %v", tt.s, err)
+			continue
+		}
+		if hrp != tt.hrp {
+			t.Errorf("Decode(%s) hrp = %s, want %s", tt.s, hrp, tt.hrp)
+		}
+		if tt.data != nil && !bytes.Equal(data, tt.data) {
+			t.Errorf("Decode(%s) data = %x, want %x", tt.s, data, tt.data)
+		}
+
+		enc, err := Encode(hrp, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enc != strings.ToLower(tt.s) {
+			t.Errorf("Encode(Decode(%s)) = %s", tt.s, enc)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for n := 0; n <= 64; n++ {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i*37 + n)
+		}
+		s, err := Encode("addr", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hrp, got, err := Decode(s)
+		if err != nil {
+			t.Fatalf("Decode(%s): %v", s, err)
+		}
+		if hrp != "addr" || !bytes.Equal(got, data) {
+			t.Fatalf("Decode(Encode(%x)) = %s, %x", data, hrp, got)
+		}
+		if _, _, err := Decode(strings.ToUpper(s)); err != nil {
+			t.Fatalf("Decode(upper case %s): %v", s, err)
+		}
+	}
+
+	if _, err := Encode("", []byte{1}); err == nil {
+		t.Error("Encode with empty hrp succeeded")
+	}
+}
+
+// encodeValues builds a string with a valid checksum over 5 bit values.
+func encodeValues(hrp string, values []byte) string {
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range append(values, checksum(hrp, values)...) {
+		sb.WriteByte(charset[v])
+	}
+	return sb.String()
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	const valid = "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"
+
+	tests := []struct {
+		name string
+		s    string
+		err  error
+	}{
+		{"checksum", valid[:len(valid)-1] + "4", ErrInvalidChecksum},
+		{"changed data", strings.Replace(valid, "rjkl", "rjkm", 1), ErrInvalidChecksum},
+		{"changed hrp", "assed" + valid[5:], ErrInvalidChecksum},
+		{"checksum of upper case hrp", "A1G7SGD8", ErrInvalidChecksum},
+		{"mixed case", "Asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3", nil},
+		{"mixed case data", "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlC3", nil},
+		{"no separator", "assetrjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3", nil},
+		{"empty hrp", "1qzzfhee", nil},
+		{"short checksum", "li1dgmt3", nil},
+		{"invalid character", "x1b4n0q5v", nil},
+		{"invalid hrp character", "\x7f1axkwrx", nil},
+		{"non-zero padding", encodeValues("a", []byte{31}), nil},
+		{"excess padding", encodeValues("a", []byte{0, 0, 0}), nil},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hrp, data, err := Decode(tt.s)
+			if err == nil {
+				t.Fatalf("Decode(%q) = %s, %x, want error", tt.s, hrp, data)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("Decode(%q) = %v, want %v", tt.s, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestConvertBits(t *testing.T) {
+	got, err := ConvertBits([]byte{0xff}, 8, 5, true)
+	if err != nil || !bytes.Equal(got, []byte{31, 28}) {
+		t.Fatalf("ConvertBits(ff, 8, 5) = %v, %v", got, err)
+	}
+	if _, err := ConvertBits([]byte{32}, 5, 8, false); err == nil {
+		t.Error("ConvertBits accepted a value out of range")
+	}
+}
@@ -0,0 +1,444 @@
+// Package cbor implements the subset of CBOR (RFC 8949) used by Cardano
+// ledger structures.
+//
+// Decoded items are represented as:
+//
+//	unsigned integer      uint64
+//	negative integer      *big.Int
+//	bignum (tags 2 and 3) *big.Int
+//	byte string           []byte
+//	text string           string
+//	array                 []interface{}
+//	map                   Map
+//	other tags            Tag
+//	true, false           bool
+//	null, undefined       nil
+//	floats                float64
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorBytes    = 2
+	majorText     = 3
+	majorArray    = 4
+	majorMap      = 5
+	majorTag      = 6
+	majorSimple   = 7
+
+	tagPositiveBignum = 2
+	tagNegativeBignum = 3
+
+	// maxDepth bounds the nesting of decoded items.
+	maxDepth = 256
+)
+
+var (
+	// ErrUnexpectedEnd is returned when the input ends in the middle of an
+	// item.
+	ErrUnexpectedEnd = errors.New("cbor: unexpected end of input")
+	// ErrTrailingData is returned by Unmarshal when bytes remain after the
+	// first item.
+	ErrTrailingData = errors.New("cbor: trailing data")
+)
+
+// Pair is an entry of a Map.
+type Pair struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Map is a CBOR map. Entries are kept in encoding order.
+type Map []Pair
+
+// Tag is a tagged item.
+type Tag struct {
+	Number  uint64
+	Content interface{}
+}
+
+// Indefinite wraps an array to encode it with an indefinite length, as
+// Plutus data arrays usually are.
+type Indefinite []interface{}
+
+// Unmarshal decodes the single CBOR item in data.
+func Unmarshal(data []byte) (interface{}, error) {
+	v, rest, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, ErrTrailingData
+	}
+	return v, nil
+}
+
+// Decode decodes the first CBOR item in data and returns the remaining
+// bytes.
+func Decode(data []byte) (v interface{}, rest []byte, err error) {
+	d := decoder{data: data}
+	v, err = d.item(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, d.data[d.off:], nil
+}
+
+type decoder struct {
+	data []byte
+	off  int
+}
+
+func (d *decoder) byte() (byte, error) {
+	if d.off >= len(d.data) {
+		return 0, ErrUnexpectedEnd
+	}
+	b := d.data[d.off]
+	d.off++
+	return b, nil
+}
+
+func (d *decoder) bytes(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.off) {
+		return nil, ErrUnexpectedEnd
+	}
+	b := d.data[d.off : d.off+int(n)]
+	d.off += int(n)
+	return b, nil
+}
+
+// head reads the initial byte and argument of an item. indefinite is true
+// for the indefinite length marker.
+func (d *decoder) head() (major byte, info byte, arg uint64, indefinite bool, err error) {
+	b, err := d.byte()
+	if err != nil {
+		return
+	}
+	major, info = b>>5, b&0x1f
+
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info <= 27:
+		var buf []byte
+		if buf, err = d.bytes(1 << (info - 24)); err != nil {
+			return
+		}
+		for _, c := range buf {
+			arg = arg<<8 | uint64(c)
+		}
+	case info == 31:
+		indefinite = true
+	default:
+		err = fmt.Errorf("cbor: invalid additional information %d", info)
+	}
+	return
+}
+
+// isBreak consumes the break marker if it is the next byte.
+func (d *decoder) isBreak() (bool, error) {
+	if d.off >= len(d.data) {
+		return false, ErrUnexpectedEnd
+	}
+	if d.data[d.off] == 0xff {
+		d.off++
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *decoder) item(depth int) (interface{}, error) {
+	if depth > maxDepth {
+		return nil, errors.New("cbor: maximum nesting depth exceeded")
+	}
+
+	major, info, arg, indefinite, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	if indefinite && (major == majorUnsigned || major == majorNegative || major == majorTag) {
+		return nil, fmt.Errorf("cbor: indefinite length not allowed for major type %d", major)
+	}
+
+	switch major {
+	case majorUnsigned:
+		return arg, nil
+
+	case majorNegative:
+		n := new(big.Int).SetUint64(arg)
+		return n.Neg(n).Sub(n, big.NewInt(1)), nil
+
+	case majorBytes, majorText:
+		var b []byte
+		if indefinite {
+			b, err = d.chunks(major)
+		} else {
+			b, err = d.bytes(arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if major == majorText {
+			return string(b), nil
+		}
+		return append([]byte{}, b...), nil
+
+	case majorArray:
+		arr := []interface{}{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			if indefinite {
+				if end, err := d.isBreak(); err != nil {
+					return nil, err
+				} else if end {
+					break
+				}
+			} else if arg-i > uint64(len(d.data)-d.off) {
+				return nil, ErrUnexpectedEnd
+			}
+			v, err := d.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+
+	case majorMap:
+		m := Map{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			if indefinite {
+				if end, err := d.isBreak(); err != nil {
+					return nil, err
+				} else if end {
+					break
+				}
+			} else if arg-i > uint64(len(d.data)-d.off) {
+				return nil, ErrUnexpectedEnd
+			}
+			k, err := d.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			v, err := d.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, Pair{Key: k, Value: v})
+		}
+		return m, nil
+
+	case majorTag:
+		content, err := d.item(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if arg == tagPositiveBignum || arg == tagNegativeBignum {
+			b, ok := content.([]byte)
+			if !ok {
+				return nil, errors.New("cbor: bignum content is not a byte string")
+			}
+			n := new(big.Int).SetBytes(b)
+			if arg == tagNegativeBignum {
+				n.Neg(n).Sub(n, big.NewInt(1))
+			}
+			return n, nil
+		}
+		return Tag{Number: arg, Content: content}, nil
+
+	default:
+		switch info {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22, 23:
+			return nil, nil
+		case 25:
+			return float16(uint16(arg)), nil
+		case 26:
+			return float64(math.Float32frombits(uint32(arg))), nil
+		case 27:
+			return math.Float64frombits(arg), nil
+		}
+		return nil, fmt.Errorf("cbor: unsupported simple value %d", info)
+	}
+}
+
+// chunks reads the chunks of an indefinite length byte or text string.
+func (d *decoder) chunks(major byte) ([]byte, error) {
+	var buf []byte
+	for {
+		if end, err := d.isBreak(); err != nil {
+			return nil, err
+		} else if end {
+			return buf, nil
+		}
+
+		m, _, n, indefinite, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if m != major || indefinite {
+			return nil, errors.New("cbor: invalid indefinite length string chunk")
+		}
+		b, err := d.bytes(n)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, b...)
+	}
+}
+
+func float16(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var v float64
+	switch exp {
+	case 0:
+		v = mant * math.Pow(2, -24)
+	case 31:
+		if mant == 0 {
+			v = math.Inf(1)
+		} else {
+			v = math.NaN()
+		}
+	default:
+		v = (mant + 1024) * math.Pow(2, float64(exp-25))
+	}
+	if h&0x8000 != 0 {
+		v = -v
+	}
+	return v
+}
+
+// Marshal encodes v. It accepts the types produced by Decode, Indefinite,
+// and the integer types int, int64 and uint.
+func Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encode(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeHead(buf *bytes.Buffer, major byte, arg uint64) {
+	switch {
+	case arg < 24:
+		buf.WriteByte(major<<5 | byte(arg))
+	case arg <= math.MaxUint8:
+		buf.WriteByte(major<<5 | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= math.MaxUint16:
+		buf.WriteByte(major<<5 | 25)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(arg)))
+	case arg <= math.MaxUint32:
+		buf.WriteByte(major<<5 | 26)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(arg)))
+	default:
+		buf.WriteByte(major<<5 | 27)
+		buf.Write(binary.BigEndian.AppendUint64(nil, arg))
+	}
+}
+
+func encode(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteByte(0xf6)
+	case bool:
+		if v {
+			buf.WriteByte(0xf5)
+		} else {
+			buf.WriteByte(0xf4)
+		}
+	case uint64:
+		writeHead(buf, majorUnsigned, v)
+	case uint:
+		writeHead(buf, majorUnsigned, uint64(v))
+	case int:
+		return encode(buf, int64(v))
+	case int64:
+		if v >= 0 {
+			writeHead(buf, majorUnsigned, uint64(v))
+		} else {
+			writeHead(buf, majorNegative, uint64(-(v + 1)))
+		}
+	case *big.Int:
+		encodeBig(buf, v)
+	case []byte:
+		writeHead(buf, majorBytes, uint64(len(v)))
+		buf.Write(v)
+	case string:
+		writeHead(buf, majorText, uint64(len(v)))
+		buf.WriteString(v)
+	case []interface{}:
+		writeHead(buf, majorArray, uint64(len(v)))
+		for _, e := range v {
+			if err := encode(buf, e); err != nil {
+				return err
+			}
+		}
+	case Indefinite:
+		buf.WriteByte(majorArray<<5 | 31)
+		for _, e := range v {
+			if err := encode(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(0xff)
+	case Map:
+		writeHead(buf, majorMap, uint64(len(v)))
+		for _, p := range v {
+			if err := encode(buf, p.Key); err != nil {
+				return err
+			}
+			if err := encode(buf, p.Value); err != nil {
+				return err
+			}
+		}
+	case Tag:
+		writeHead(buf, majorTag, v.Number)
+		return encode(buf, v.Content)
+	case float64:
+		buf.WriteByte(majorSimple<<5 | 27)
+		buf.Write(binary.BigEndian.AppendUint64(nil, math.Float64bits(v)))
+	default:
+		return fmt.Errorf("cbor: unsupported type %T", v)
+	}
+	return nil
+}
+
+func encodeBig(buf *bytes.Buffer, v *big.Int) {
+	if v.IsUint64() {
+		writeHead(buf, majorUnsigned, v.Uint64())
+		return
+	}
+
+	if v.Sign() < 0 {
+		// -1 - n encodes n.
+		n := new(big.Int).Neg(v)
+		n.Sub(n, big.NewInt(1))
+		if n.IsUint64() {
+			writeHead(buf, majorNegative, n.Uint64())
+			return
+		}
+		writeHead(buf, majorTag, tagNegativeBignum)
+		b := n.Bytes()
+		writeHead(buf, majorBytes, uint64(len(b)))
+		buf.Write(b)
+		return
+	}
+
+	writeHead(buf, majorTag, tagPositiveBignum)
+	b := v.Bytes()
+	writeHead(buf, majorBytes, uint64(len(b)))
+	buf.Write(b)
+}
@@ -0,0 +1,237 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func bigInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic(s)
+	}
+	return n
+}
+
+// equal compares decoded items, comparing big integers by value.
+func equal(a, b interface{}) bool {
+	switch a := a.(type) {
+	case *big.Int:
+		b, ok := b.(*big.Int)
+		return ok && a.Cmp(b) == 0
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case Map:
+		b, ok := b.(Map)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i].Key, b[i].Key) || !equal(a[i].Value, b[i].Value) {
+				return false
+			}
+		}
+		return true
+	case Tag:
+		b, ok := b.(Tag)
+		return ok && a.Number == b.Number && equal(a.Content, b.Content)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		hex   string
+		value interface{}
+	}{
+		{"uint 0", "00", uint64(0)},
+		{"uint 23", "17", uint64(23)},
+		{"uint 24", "1818", uint64(24)},
+		{"uint 256", "190100", uint64(256)},
+		{"uint 2^32", "1b0000000100000000", uint64(1 << 32)},
+		{"uint max", "1bffffffffffffffff", uint64(1<<64 - 1)},
+		{"negint -1", "20", big.NewInt(-1)},
+		{"negint -500", "3901f3", big.NewInt(-500)},
+		{"negint min", "3bffffffffffffffff", bigInt("-18446744073709551616")},
+		{"bignum 2^64", "c249010000000000000000", bigInt("18446744073709551616")},
+		{"negative bignum", "c349010000000000000000", bigInt("-18446744073709551617")},
+		{"bytes", "43010203", []byte{1, 2, 3}},
+		{"empty bytes", "40", []byte{}},
+		{"text", "6449455446", "IETF"},
+		{"array", "83010203", []interface{}{uint64(1), uint64(2), uint64(3)}},
+		{"nested array", "8201820203", []interface{}{uint64(1), []interface{}{uint64(2), uint64(3)}}},
+		{"map", "a201020304", Map{{uint64(1), uint64(2)}, {uint64(3), uint64(4)}}},
+		{"tag", "d87980", Tag{Number: 121, Content: []interface{}{}}},
+		{"true", "f5", true},
+		{"false", "f4", false},
+		{"null", "f6", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := mustHex(t, tt.hex)
+			v, err := Unmarshal(data)
+			if err != nil {
+				t.Fatalf("Unmarshal(%s): %v", tt.hex, err)
+			}
+			if !equal(v, tt.value) {
+				t.Fatalf("Unmarshal(%s) = %#v, want %#v", tt.hex, v, tt.value)
+			}
+			enc, err := Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal(%#v): %v", tt.value, err)
+			}
+			if !bytes.Equal(enc, data) {
+				t.Fatalf("Marshal(%#v) = %x, want %s", tt.value, enc, tt.hex)
+			}
+		})
+	}
+}
+
+func TestMarshalIntegers(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		hex   string
+	}{
+		{0, "00"},
+		{-1, "20"},
+		{int64(-25), "3818"},
+		{uint(1000), "1903e8"},
+		{big.NewInt(100), "1864"},
+		{big.NewInt(-100), "3863"},
+	}
+	for _, tt := range tests {
+		enc, err := Marshal(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(enc) != tt.hex {
+			t.Errorf("Marshal(%v) = %x, want %s", tt.value, enc, tt.hex)
+		}
+	}
+
+	if _, err := Marshal(struct{}{}); err == nil {
+		t.Error("Marshal of an unsupported type succeeded")
+	}
+}
+
+func TestIndefinite(t *testing.T) {
+	tests := []struct {
+		name  string
+		hex   string
+		value interface{}
+	}{
+		{"array", "9f0102ff", []interface{}{uint64(1), uint64(2)}},
+		{"empty array", "9fff", []interface{}{}},
+		{"nested", "9f019f02ffff", []interface{}{uint64(1), []interface{}{uint64(2)}}},
+		{"map", "bf0102ff", Map{{uint64(1), uint64(2)}}},
+		{"bytes", "5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+		{"text", "7f657374726561646d696e67ff", "streaming"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Unmarshal(mustHex(t, tt.hex))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(v, tt.value) {
+				t.Fatalf("Unmarshal(%s) = %#v, want %#v", tt.hex, v, tt.value)
+			}
+		})
+	}
+
+	enc, err := Marshal(Indefinite{uint64(1), Indefinite{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(enc); got != "9f019fffff" {
+		t.Errorf("Marshal(Indefinite) = %s, want 9f019fffff", got)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		err  error
+	}{
+		{"empty", "", ErrUnexpectedEnd},
+		{"truncated uint", "19 01", ErrUnexpectedEnd},
+		{"truncated bytes", "43 0102", ErrUnexpectedEnd},
+		{"truncated array", "83 0102", ErrUnexpectedEnd},
+		{"huge array", "9b ffffffffffffffff", ErrUnexpectedEnd},
+		{"truncated map", "a2 0102", ErrUnexpectedEnd},
+		{"unterminated indefinite array", "9f 0102", ErrUnexpectedEnd},
+		{"unterminated indefinite bytes", "5f 4101", ErrUnexpectedEnd},
+		{"truncated tag", "d8", ErrUnexpectedEnd},
+		{"trailing data", "01 02", ErrTrailingData},
+		{"reserved additional info", "1c", nil},
+		{"indefinite uint", "1f", nil},
+		{"indefinite tag", "df", nil},
+		{"mixed string chunks", "5f 6161 ff", nil},
+		{"nested indefinite chunk", "5f 5f ff ff", nil},
+		{"bignum of text", "c2 6161", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Unmarshal(mustHex(t, tt.hex))
+			if err == nil {
+				t.Fatalf("Unmarshal(%s) = %#v, want error", tt.hex, v)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("Unmarshal(%s) = %v, want %v", tt.hex, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDepthLimit(t *testing.T) {
+	nested := func(depth int) []byte {
+		return append(bytes.Repeat([]byte{0x81}, depth), 0x00)
+	}
+
+	if _, err := Unmarshal(nested(maxDepth)); err != nil {
+		t.Fatalf("depth %d: %v", maxDepth, err)
+	}
+	if _, err := Unmarshal(nested(maxDepth + 1)); err == nil {
+		t.Fatalf("depth %d decoded, want error", maxDepth+1)
+	}
+	// A deep indefinite array must not exhaust the stack either.
+	if _, err := Unmarshal(bytes.Repeat([]byte{0x9f}, 1<<20)); err == nil {
+		t.Fatal("deeply nested indefinite arrays decoded, want error")
+	}
+}
+
+func TestDecodeRest(t *testing.T) {
+	v, rest, err := Decode(mustHex(t, "01 8102"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != uint64(1) || hex.EncodeToString(rest) != "8102" {
+		t.Fatalf("Decode = %v, %x", v, rest)
+	}
+}
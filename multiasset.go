@@ -0,0 +1,325 @@
+package tangocrypto_go
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ripoff2/tangocrypto-go/internal/cbor"
+)
+
+// MultiAsset is an amount of ADA together with native assets, such as the
+// value of a UTxO. Assets are keyed by policy ID and asset name, both hex
+// encoded. The zero value is an empty value.
+//
+// Methods never modify their receiver or arguments; they return new values.
+type MultiAsset struct {
+	Coin   Lovelace
+	Assets map[string]map[string]Quantity
+}
+
+// NewMultiAsset returns a value holding coin and no assets.
+func NewMultiAsset(coin Lovelace) MultiAsset {
+	return MultiAsset{Coin: coin}
+}
+
+// MultiAssetFromAssets returns a value holding assets and no ADA, such as
+// the assets of a TransactionContent. Duplicate entries are summed.
+func MultiAssetFromAssets(assets []Assets) MultiAsset {
+	m := MultiAsset{}
+	for _, a := range assets {
+		m.addAsset(a.PolicyID, a.AssetName, a.Quantity)
+	}
+	return m
+}
+
+// MultiAsset returns the value held by the UTxO.
+func (d Data) MultiAsset() MultiAsset {
+	m := MultiAssetFromAssets(d.Assets)
+	m.Coin = d.Value
+	return m
+}
+
+// MultiAsset returns the value of the transaction input or output.
+func (u TransactionUTXO) MultiAsset() MultiAsset {
+	m := MultiAssetFromAssets(u.Assets)
+	m.Coin = u.Value
+	return m
+}
+
+// MultiAsset returns the amount as a multi-asset value.
+func (a AddressAmount) MultiAsset() MultiAsset {
+	m := MultiAssetFromAssets(a.Assets)
+	m.Coin = a.Value
+	return m
+}
+
+// Quantity returns the quantity of an asset, zero when absent.
+func (m MultiAsset) Quantity(policyID, assetName string) Quantity {
+	return m.Assets[policyID][assetName]
+}
+
+// WithAsset returns a copy of m where the quantity of an asset is set to q.
+func (m MultiAsset) WithAsset(policyID, assetName string, q Quantity) MultiAsset {
+	c := m.clone()
+	if c.Assets == nil {
+		c.Assets = map[string]map[string]Quantity{}
+	}
+	if c.Assets[policyID] == nil {
+		c.Assets[policyID] = map[string]Quantity{}
+	}
+	c.Assets[policyID][assetName] = q
+	return c
+}
+
+func (m MultiAsset) clone() MultiAsset {
+	c := MultiAsset{Coin: m.Coin}
+	if m.Assets == nil {
+		return c
+	}
+	c.Assets = make(map[string]map[string]Quantity, len(m.Assets))
+	for policy, names := range m.Assets {
+		c.Assets[policy] = make(map[string]Quantity, len(names))
+		for name, q := range names {
+			c.Assets[policy][name] = q
+		}
+	}
+	return c
+}
+
+// addAsset adds q to an asset of m in place.
+func (m *MultiAsset) addAsset(policyID, assetName string, q Quantity) {
+	if m.Assets == nil {
+		m.Assets = map[string]map[string]Quantity{}
+	}
+	if m.Assets[policyID] == nil {
+		m.Assets[policyID] = map[string]Quantity{}
+	}
+	m.Assets[policyID][assetName] = m.Assets[policyID][assetName].Add(q)
+}
+
+// Add returns m+o, or ErrOverflow when the ADA amount overflows.
+func (m MultiAsset) Add(o MultiAsset) (MultiAsset, error) {
+	coin, err := m.Coin.Add(o.Coin)
+	if err != nil {
+		return MultiAsset{}, err
+	}
+
+	r := m.clone()
+	r.Coin = coin
+	for policy, names := range o.Assets {
+		for name, q := range names {
+			r.addAsset(policy, name, q)
+		}
+	}
+	return r.Normalize(), nil
+}
+
+// Sub returns m-o, or ErrNegativeAmount when o holds more ADA or more of any
+// asset than m.
+func (m MultiAsset) Sub(o MultiAsset) (MultiAsset, error) {
+	coin, err := m.Coin.Sub(o.Coin)
+	if err != nil {
+		return MultiAsset{}, err
+	}
+
+	r := m.clone()
+	r.Coin = coin
+	for policy, names := range o.Assets {
+		for name, q := range names {
+			r.addAsset(policy, name, q.Neg())
+			if r.Assets[policy][name].Sign() < 0 {
+				return MultiAsset{}, ErrNegativeAmount
+			}
+		}
+	}
+	return r.Normalize(), nil
+}
+
+// GreaterOrEqual reports whether m holds at least as much ADA and as much of
+// every asset as o.
+func (m MultiAsset) GreaterOrEqual(o MultiAsset) bool {
+	if m.Coin < o.Coin {
+		return false
+	}
+	for policy, names := range o.Assets {
+		for name, q := range names {
+			if m.Quantity(policy, name).Cmp(q) < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsZero reports whether m holds no ADA and no asset.
+func (m MultiAsset) IsZero() bool {
+	if m.Coin != 0 {
+		return false
+	}
+	for _, names := range m.Assets {
+		for _, q := range names {
+			if !q.IsZero() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Normalize returns a copy of m without zero quantities and empty policies.
+func (m MultiAsset) Normalize() MultiAsset {
+	r := MultiAsset{Coin: m.Coin}
+	for policy, names := range m.Assets {
+		for name, q := range names {
+			if !q.IsZero() {
+				r.addAsset(policy, name, q)
+			}
+		}
+	}
+	return r
+}
+
+// multiAssetJSON is the JSON representation of MultiAsset.
+type multiAssetJSON struct {
+	Coin   Lovelace                       `json:"coin"`
+	Assets map[string]map[string]Quantity `json:"assets,omitempty"`
+}
+
+func (m MultiAsset) MarshalJSON() ([]byte, error) {
+	n := m.Normalize()
+	return json.Marshal(multiAssetJSON{Coin: n.Coin, Assets: n.Assets})
+}
+
+func (m *MultiAsset) UnmarshalJSON(data []byte) error {
+	var v multiAssetJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	// Keys are checked here rather than when encoding to CBOR, and stored
+	// in lower case so that lookups match the values the API returns.
+	r := MultiAsset{Coin: v.Coin}
+	for policy, names := range v.Assets {
+		policyID, err := hex.DecodeString(policy)
+		if err != nil || len(policyID) != 28 {
+			return fmt.Errorf("multiasset: invalid policy id %q", policy)
+		}
+		for name, q := range names {
+			assetName, err := hex.DecodeString(name)
+			if err != nil || len(assetName) > 32 {
+				return fmt.Errorf("multiasset: invalid asset name %q", name)
+			}
+			r.addAsset(hex.EncodeToString(policyID), hex.EncodeToString(assetName), q)
+		}
+	}
+	*m = r
+	return nil
+}
+
+// MarshalCBOR encodes m in the ledger format: the coin alone when m holds no
+// asset, otherwise [coin, {policy_id => {asset_name => quantity}}] with keys
+// in canonical order.
+func (m MultiAsset) MarshalCBOR() ([]byte, error) {
+	n := m.Normalize()
+	if len(n.Assets) == 0 {
+		return cbor.Marshal(uint64(n.Coin))
+	}
+
+	policies := cbor.Map{}
+	for _, policy := range sortedHexKeys(n.Assets) {
+		policyID, err := hex.DecodeString(policy)
+		if err != nil {
+			return nil, fmt.Errorf("multiasset: invalid policy id %q", policy)
+		}
+
+		assets := cbor.Map{}
+		for _, name := range sortedHexKeys(n.Assets[policy]) {
+			assetName, err := hex.DecodeString(name)
+			if err != nil {
+				return nil, fmt.Errorf("multiasset: invalid asset name %q", name)
+			}
+			assets = append(assets, cbor.Pair{Key: assetName, Value: n.Assets[policy][name].BigInt()})
+		}
+		policies = append(policies, cbor.Pair{Key: policyID, Value: assets})
+	}
+
+	return cbor.Marshal([]interface{}{uint64(n.Coin), policies})
+}
+
+func (m *MultiAsset) UnmarshalCBOR(data []byte) error {
+	v, err := cbor.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case uint64:
+		*m = MultiAsset{Coin: Lovelace(v)}
+		return nil
+	case []interface{}:
+		if len(v) != 2 {
+			break
+		}
+		coin, ok := v[0].(uint64)
+		policies, ok2 := v[1].(cbor.Map)
+		if !ok || !ok2 {
+			break
+		}
+
+		r := MultiAsset{Coin: Lovelace(coin)}
+		for _, p := range policies {
+			policyID, ok := p.Key.([]byte)
+			assets, ok2 := p.Value.(cbor.Map)
+			if !ok || !ok2 {
+				return errors.New("multiasset: invalid policy entry")
+			}
+			for _, a := range assets {
+				assetName, ok := a.Key.([]byte)
+				if !ok {
+					return errors.New("multiasset: invalid asset name")
+				}
+				q, err := cborQuantity(a.Value)
+				if err != nil {
+					return err
+				}
+				r.addAsset(hex.EncodeToString(policyID), hex.EncodeToString(assetName), q)
+			}
+		}
+		*m = r
+		return nil
+	}
+
+	return errors.New("multiasset: invalid value encoding")
+}
+
+func cborQuantity(v interface{}) (Quantity, error) {
+	switch v := v.(type) {
+	case uint64:
+		return QuantityFromUint64(v), nil
+	case *big.Int:
+		return QuantityFromBig(v), nil
+	}
+	return Quantity{}, errors.New("multiasset: invalid asset quantity")
+}
+
+// sortedHexKeys returns the keys of m, hex encoded byte strings, in the
+// canonical CBOR order of the bytes: shorter first, then lexicographic.
+func sortedHexKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return bytes.Compare([]byte(a), []byte(b)) < 0
+	})
+	return keys
+}
@@ -0,0 +1,264 @@
+package tangocrypto_go
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+const (
+	testPolicyA = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	testPolicyB = "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209"
+)
+
+func mustQuantity(t *testing.T, s string) Quantity {
+	t.Helper()
+	q, err := ParseQuantity(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+// equalMultiAsset compares values by amount, ignoring zero quantities.
+func equalMultiAsset(a, b MultiAsset) bool {
+	a, b = a.Normalize(), b.Normalize()
+	if a.Coin != b.Coin || len(a.Assets) != len(b.Assets) {
+		return false
+	}
+	for policy, names := range a.Assets {
+		if len(names) != len(b.Assets[policy]) {
+			return false
+		}
+		for name, q := range names {
+			if q.Cmp(b.Quantity(policy, name)) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMultiAssetCBOR(t *testing.T) {
+	tests := []struct {
+		name  string
+		value MultiAsset
+		hex   string
+	}{
+		{
+			name:  "coin only",
+			value: NewMultiAsset(1_000_000),
+			hex:   "1a000f4240",
+		},
+		{
+			name:  "zero quantities dropped",
+			value: NewMultiAsset(1).WithAsset(testPolicyA, "", Quantity{}),
+			hex:   "01",
+		},
+		{
+			name: "canonical order",
+			value: NewMultiAsset(2).
+				WithAsset(testPolicyA, "0000", NewQuantity(4)).
+				WithAsset(testPolicyA, "ff", NewQuantity(3)).
+				WithAsset(testPolicyA, "", NewQuantity(1)).
+				WithAsset(testPolicyA, "00", NewQuantity(2)).
+				WithAsset(testPolicyB, "01", NewQuantity(5)),
+			hex: "8202" + "a2" +
+				"581c" + testPolicyB + "a1" + "4101" + "05" +
+				"581c" + testPolicyA + "a4" + "40" + "01" + "4100" + "02" + "41ff" + "03" + "420000" + "04",
+		},
+		{
+			name:  "quantity 2^63",
+			value: NewMultiAsset(0).WithAsset(testPolicyA, "01", mustQuantity(t, "9223372036854775808")),
+			hex:   "8200" + "a1" + "581c" + testPolicyA + "a1" + "4101" + "1b8000000000000000",
+		},
+		{
+			name:  "quantity 2^64-1",
+			value: NewMultiAsset(0).WithAsset(testPolicyA, "01", mustQuantity(t, "18446744073709551615")),
+			hex:   "8200" + "a1" + "581c" + testPolicyA + "a1" + "4101" + "1bffffffffffffffff",
+		},
+		{
+			name:  "quantity 2^64",
+			value: NewMultiAsset(0).WithAsset(testPolicyA, "01", mustQuantity(t, "18446744073709551616")),
+			hex:   "8200" + "a1" + "581c" + testPolicyA + "a1" + "4101" + "c249010000000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := tt.value.MarshalCBOR()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(enc); got != tt.hex {
+				t.Fatalf("MarshalCBOR = %s, want %s", got, tt.hex)
+			}
+
+			var dec MultiAsset
+			if err := dec.UnmarshalCBOR(enc); err != nil {
+				t.Fatal(err)
+			}
+			if !equalMultiAsset(dec, tt.value) {
+				t.Fatalf("UnmarshalCBOR = %+v, want %+v", dec, tt.value)
+			}
+		})
+	}
+}
+
+func TestMultiAssetCBORInvalid(t *testing.T) {
+	for _, h := range []string{
+		"",                         // empty
+		"20",                       // negative coin
+		"8101",                     // missing assets
+		"820140",                   // assets not a map
+		"8201a14001",               // policy entry not a map
+		"8201a140a1" + "01" + "01", // asset name not bytes
+		"8201a140a140" + "6161",    // quantity not an integer
+	} {
+		raw, _ := hex.DecodeString(h)
+		var m MultiAsset
+		if err := m.UnmarshalCBOR(raw); err == nil {
+			t.Errorf("UnmarshalCBOR(%q) = %+v, want error", h, m)
+		}
+	}
+}
+
+func TestMultiAssetJSON(t *testing.T) {
+	value := NewMultiAsset(1_500_000).
+		WithAsset(testPolicyA, "", mustQuantity(t, "18446744073709551616")).
+		WithAsset(testPolicyA, "01", mustQuantity(t, "9223372036854775808")).
+		WithAsset(testPolicyB, "02", Quantity{})
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"coin":1500000,"assets":{"` + testPolicyA + `":{"":18446744073709551616,"01":9223372036854775808}}}`
+	if string(b) != want {
+		t.Fatalf("MarshalJSON = %s, want %s", b, want)
+	}
+
+	var dec MultiAsset
+	if err := json.Unmarshal(b, &dec); err != nil {
+		t.Fatal(err)
+	}
+	if !equalMultiAsset(dec, value) {
+		t.Fatalf("UnmarshalJSON = %+v, want %+v", dec, value)
+	}
+
+	// Quantities may also be strings, as the API returns them.
+	const quoted = `{"coin":"1500000","assets":{"` + testPolicyA + `":{"":"18446744073709551616","01":"9223372036854775808"}}}`
+	dec = MultiAsset{}
+	if err := json.Unmarshal([]byte(quoted), &dec); err != nil {
+		t.Fatal(err)
+	}
+	if !equalMultiAsset(dec, value) {
+		t.Fatalf("UnmarshalJSON(quoted) = %+v, want %+v", dec, value)
+	}
+}
+
+func TestMultiAssetJSONInvalidKeys(t *testing.T) {
+	for _, body := range []string{
+		`{"coin":1,"assets":{"zz":{"01":1}}}`,
+		`{"coin":1,"assets":{"` + testPolicyA[2:] + `":{"01":1}}}`,
+		`{"coin":1,"assets":{"` + testPolicyA + `":{"0g":1}}}`,
+		`{"coin":1,"assets":{"` + testPolicyA + `":{"abc":1}}}`,
+		`{"coin":1,"assets":{"` + testPolicyA + `":{"` + strings.Repeat("00", 33) + `":1}}}`,
+	} {
+		var m MultiAsset
+		if err := json.Unmarshal([]byte(body), &m); err == nil {
+			t.Errorf("UnmarshalJSON(%s) = %+v, want error", body, m)
+		}
+	}
+
+	var m MultiAsset
+	if err := json.Unmarshal([]byte(`{"coin":1,"assets":{"`+strings.ToUpper(testPolicyA)+`":{"AB":2}}}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if q := m.Quantity(testPolicyA, "ab"); q.Cmp(NewQuantity(2)) != 0 {
+		t.Errorf("upper case keys: quantity %s, want 2", q)
+	}
+}
+
+func TestMultiAssetArithmetic(t *testing.T) {
+	a := NewMultiAsset(5).
+		WithAsset(testPolicyA, "01", NewQuantity(10)).
+		WithAsset(testPolicyB, "", NewQuantity(1))
+	b := NewMultiAsset(3).
+		WithAsset(testPolicyA, "01", NewQuantity(4)).
+		WithAsset(testPolicyA, "02", mustQuantity(t, "18446744073709551616"))
+
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewMultiAsset(8).
+		WithAsset(testPolicyA, "01", NewQuantity(14)).
+		WithAsset(testPolicyA, "02", mustQuantity(t, "18446744073709551616")).
+		WithAsset(testPolicyB, "", NewQuantity(1))
+	if !equalMultiAsset(sum, want) {
+		t.Errorf("Add = %+v, want %+v", sum, want)
+	}
+	if a.Quantity(testPolicyA, "01").Cmp(NewQuantity(10)) != 0 || len(a.Assets[testPolicyA]) != 1 {
+		t.Error("Add modified its receiver")
+	}
+
+	diff, err := sum.Sub(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equalMultiAsset(diff, a) {
+		t.Errorf("Sub = %+v, want %+v", diff, a)
+	}
+	if _, ok := diff.Assets[testPolicyA]["02"]; ok {
+		t.Error("Sub kept a zero quantity")
+	}
+
+	if _, err := NewMultiAsset(math.MaxUint64).Add(NewMultiAsset(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("coin overflow: %v, want ErrOverflow", err)
+	}
+	if _, err := a.Sub(NewMultiAsset(6)); !errors.Is(err, ErrNegativeAmount) {
+		t.Errorf("coin deficit: %v, want ErrNegativeAmount", err)
+	}
+	if _, err := a.Sub(b); !errors.Is(err, ErrNegativeAmount) {
+		t.Errorf("asset deficit: %v, want ErrNegativeAmount", err)
+	}
+	if _, err := a.Sub(NewMultiAsset(0).WithAsset(testPolicyB, "02", NewQuantity(1))); !errors.Is(err, ErrNegativeAmount) {
+		t.Errorf("missing asset: %v, want ErrNegativeAmount", err)
+	}
+
+	if !sum.GreaterOrEqual(a) || !sum.GreaterOrEqual(b) || !a.GreaterOrEqual(a) {
+		t.Error("GreaterOrEqual is false for a smaller value")
+	}
+	if a.GreaterOrEqual(b) || b.GreaterOrEqual(a) {
+		t.Error("GreaterOrEqual is true for values that are not ordered")
+	}
+	if a.GreaterOrEqual(NewMultiAsset(6)) {
+		t.Error("GreaterOrEqual ignores the coin")
+	}
+	if !a.GreaterOrEqual(NewMultiAsset(0).WithAsset(testPolicyB, "02", Quantity{})) {
+		t.Error("GreaterOrEqual requires assets with a zero quantity")
+	}
+
+	if !(MultiAsset{}).IsZero() || !NewMultiAsset(0).WithAsset(testPolicyA, "01", Quantity{}).IsZero() {
+		t.Error("IsZero is false for an empty value")
+	}
+	if NewMultiAsset(1).IsZero() || NewMultiAsset(0).WithAsset(testPolicyA, "01", NewQuantity(-1)).IsZero() {
+		t.Error("IsZero is true for a non-empty value")
+	}
+
+	n := NewMultiAsset(1).
+		WithAsset(testPolicyA, "01", Quantity{}).
+		WithAsset(testPolicyB, "01", NewQuantity(2)).
+		WithAsset(testPolicyB, "02", NewQuantity(0)).
+		Normalize()
+	if len(n.Assets) != 1 || len(n.Assets[testPolicyB]) != 1 || n.Quantity(testPolicyB, "01").Cmp(NewQuantity(2)) != 0 {
+		t.Errorf("Normalize = %+v", n)
+	}
+	if (MultiAsset{Coin: 1, Assets: map[string]map[string]Quantity{testPolicyA: {}}}).Normalize().Assets != nil {
+		t.Error("Normalize kept an empty policy")
+	}
+}
@@ -0,0 +1,426 @@
+package tangocrypto_go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// cip25ChunkSize is the maximum length in bytes of a metadata string.
+const cip25ChunkSize = 64
+
+// CIP-67 asset name labels used by CIP-68.
+const (
+	CIP68ReferenceLabel = 100
+	CIP68NFTLabel       = 222
+	CIP68FTLabel        = 333
+	CIP68RFTLabel       = 444
+)
+
+// ErrCIP68ReferenceNotFound is returned by ResolveCIP68 when the reference
+// token or its datum cannot be found.
+var ErrCIP68ReferenceNotFound = errors.New("tangocrypto: CIP-68 reference token not found")
+
+// MetadataViolation describes a field of NFT metadata that does not follow
+// its standard.
+type MetadataViolation struct {
+	Standard string
+	Field    string
+	Reason   string
+}
+
+func (v *MetadataViolation) Error() string {
+	return fmt.Sprintf("%s: %s: %s", v.Standard, v.Field, v.Reason)
+}
+
+// MetadataString is a metadata string that may be split in chunks of at most
+// 64 bytes, as CIP-25 requires for long values such as image URLs.
+type MetadataString []string
+
+// String returns the chunks joined together.
+func (s MetadataString) String() string {
+	return strings.Join(s, "")
+}
+
+func (s *MetadataString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var chunks []string
+		if err := json.Unmarshal(data, &chunks); err != nil {
+			return err
+		}
+		*s = chunks
+		return nil
+	}
+
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = MetadataString{v}
+	return nil
+}
+
+func (s MetadataString) MarshalJSON() ([]byte, error) {
+	if len(s) == 1 {
+		return json.Marshal(s[0])
+	}
+	return json.Marshal([]string(s))
+}
+
+// CIP25Metadata is the CIP-25 metadata of an NFT.
+type CIP25Metadata struct {
+	Name        string         `json:"name"`
+	Image       MetadataString `json:"image"`
+	MediaType   string         `json:"mediaType,omitempty"`
+	Description MetadataString `json:"description,omitempty"`
+	Files       []CIP25File    `json:"files,omitempty"`
+
+	// Extra holds the properties not defined by CIP-25.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CIP25File is a file attached to an NFT.
+type CIP25File struct {
+	Name      string         `json:"name,omitempty"`
+	MediaType string         `json:"mediaType"`
+	Src       MetadataString `json:"src"`
+}
+
+// ParseCIP25 decodes the CIP-25 metadata of a single asset, i.e. the object
+// found under 721 / policy ID / asset name.
+func ParseCIP25(data []byte) (CIP25Metadata, error) {
+	var m CIP25Metadata
+	if err := json.Unmarshal(data, &m); err != nil {
+		return CIP25Metadata{}, fmt.Errorf("cip25: %w", err)
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return CIP25Metadata{}, fmt.Errorf("cip25: %w", err)
+	}
+	for _, k := range []string{"name", "image", "mediaType", "description", "files"} {
+		delete(all, k)
+	}
+	if len(all) > 0 {
+		m.Extra = all
+	}
+
+	return m, nil
+}
+
+// CIP25 decodes the on-chain metadata of the asset as CIP-25.
+func (a AssetInfo) CIP25() (CIP25Metadata, error) {
+	if len(a.OnchainMetadata) == 0 || string(a.OnchainMetadata) == "null" {
+		return CIP25Metadata{}, errors.New("cip25: asset has no on-chain metadata")
+	}
+	return ParseCIP25(a.OnchainMetadata)
+}
+
+// Validate reports the CIP-25 violations of m, joined in a single error, or
+// nil when m is valid. Each violation is a *MetadataViolation.
+func (m CIP25Metadata) Validate() error {
+	var errs []error
+	violation := func(field, reason string) {
+		errs = append(errs, &MetadataViolation{Standard: "CIP-25", Field: field, Reason: reason})
+	}
+	checkChunks := func(field string, s MetadataString) {
+		for i, chunk := range s {
+			if len(chunk) > cip25ChunkSize {
+				violation(fmt.Sprintf("%s[%d]", field, i), fmt.Sprintf("chunk is %d bytes long, the maximum is %d", len(chunk), cip25ChunkSize))
+			}
+		}
+	}
+
+	if m.Name == "" {
+		violation("name", "is required")
+	}
+	if len(m.Image) == 0 || m.Image.String() == "" {
+		violation("image", "is required")
+	}
+	checkChunks("image", m.Image)
+	if m.MediaType != "" && !strings.HasPrefix(m.MediaType, "image/") {
+		violation("mediaType", fmt.Sprintf("%q is not an image media type", m.MediaType))
+	}
+	checkChunks("description", m.Description)
+
+	for i, f := range m.Files {
+		field := fmt.Sprintf("files[%d]", i)
+		if f.MediaType == "" {
+			violation(field+".mediaType", "is required")
+		}
+		if len(f.Src) == 0 || f.Src.String() == "" {
+			violation(field+".src", "is required")
+		}
+		checkChunks(field+".src", f.Src)
+	}
+
+	return errors.Join(errs...)
+}
+
+// CIP68Metadata is the metadata held in the inline datum of a CIP-68
+// reference token.
+type CIP68Metadata struct {
+	// Fields holds every metadata entry keyed by its UTF-8 decoded name.
+	Fields map[string]PlutusData
+	// Version is the version of the datum format.
+	Version int64
+	// Extra is the custom data following the metadata and version.
+	Extra PlutusData
+
+	Name        string
+	Image       string
+	MediaType   string
+	Description string
+	Files       []CIP25File
+
+	// Ticker, URL, Logo and Decimals are set for fungible tokens (333).
+	Ticker   string
+	URL      string
+	Logo     string
+	Decimals int
+}
+
+// ParseCIP68 decodes the datum of a CIP-68 reference token, i.e.
+// Constr 0 [metadata, version, extra].
+func ParseCIP68(d PlutusData) (CIP68Metadata, error) {
+	c, ok := d.(PlutusConstr)
+	if !ok || c.Constructor != 0 || len(c.Fields) < 2 {
+		return CIP68Metadata{}, errors.New("cip68: datum is not Constr 0 [metadata, version, extra]")
+	}
+
+	entries, ok := c.Fields[0].(PlutusMap)
+	if !ok {
+		return CIP68Metadata{}, errors.New("cip68: metadata is not a map")
+	}
+	version, ok := c.Fields[1].(PlutusInteger)
+	if !ok || !version.big().IsInt64() {
+		return CIP68Metadata{}, errors.New("cip68: version is not an integer")
+	}
+
+	m := CIP68Metadata{
+		Fields:  make(map[string]PlutusData, len(entries)),
+		Version: version.big().Int64(),
+	}
+	if len(c.Fields) > 2 {
+		m.Extra = c.Fields[2]
+	}
+
+	for _, e := range entries {
+		k, ok := e.Key.(PlutusBytes)
+		if !ok {
+			return CIP68Metadata{}, errors.New("cip68: metadata key is not a byte string")
+		}
+		m.Fields[string(k)] = e.Value
+	}
+
+	m.Name = plutusText(m.Fields["name"])
+	m.Image = plutusText(m.Fields["image"])
+	m.MediaType = plutusText(m.Fields["mediaType"])
+	m.Description = plutusText(m.Fields["description"])
+	m.Ticker = plutusText(m.Fields["ticker"])
+	m.URL = plutusText(m.Fields["url"])
+	m.Logo = plutusText(m.Fields["logo"])
+	if d, ok := m.Fields["decimals"].(PlutusInteger); ok && d.big().IsInt64() {
+		m.Decimals = int(d.big().Int64())
+	}
+
+	if files, ok := m.Fields["files"].(PlutusList); ok {
+		for _, f := range files {
+			fm, ok := f.(PlutusMap)
+			if !ok {
+				continue
+			}
+			file := CIP25File{}
+			for _, e := range fm {
+				switch plutusText(e.Key) {
+				case "name":
+					file.Name = plutusText(e.Value)
+				case "mediaType":
+					file.MediaType = plutusText(e.Value)
+				case "src":
+					file.Src = MetadataString{plutusText(e.Value)}
+				}
+			}
+			m.Files = append(m.Files, file)
+		}
+	}
+
+	return m, nil
+}
+
+// plutusText returns the UTF-8 text of bytes, or of a list of bytes chunks.
+func plutusText(d PlutusData) string {
+	switch d := d.(type) {
+	case PlutusBytes:
+		return string(d)
+	case PlutusList:
+		var sb strings.Builder
+		for _, item := range d {
+			if b, ok := item.(PlutusBytes); ok {
+				sb.Write(b)
+			}
+		}
+		return sb.String()
+	}
+	return ""
+}
+
+// Validate reports the CIP-68 violations of m for a user token of the given
+// label (222, 333 or 444), joined in a single error, or nil when m is valid.
+// Each violation is a *MetadataViolation.
+func (m CIP68Metadata) Validate(label int) error {
+	var errs []error
+	violation := func(field, reason string) {
+		errs = append(errs, &MetadataViolation{Standard: "CIP-68", Field: field, Reason: reason})
+	}
+	requireText := func(field string) {
+		v, ok := m.Fields[field]
+		if !ok {
+			violation(field, "is required")
+			return
+		}
+		if b, ok := v.(PlutusBytes); !ok || !utf8.Valid(b) {
+			violation(field, "must be a UTF-8 byte string")
+		}
+	}
+
+	if m.Version < 1 {
+		violation("version", fmt.Sprintf("%d is not a valid version", m.Version))
+	}
+
+	switch label {
+	case CIP68NFTLabel, CIP68RFTLabel:
+		requireText("name")
+		requireText("image")
+		if m.MediaType != "" && !strings.HasPrefix(m.MediaType, "image/") {
+			violation("mediaType", fmt.Sprintf("%q is not an image media type", m.MediaType))
+		}
+		for i, f := range m.Files {
+			if f.MediaType == "" {
+				violation(fmt.Sprintf("files[%d].mediaType", i), "is required")
+			}
+			if f.Src.String() == "" {
+				violation(fmt.Sprintf("files[%d].src", i), "is required")
+			}
+		}
+	case CIP68FTLabel:
+		requireText("name")
+		requireText("description")
+		if v, ok := m.Fields["decimals"]; ok {
+			if _, ok := v.(PlutusInteger); !ok {
+				violation("decimals", "must be an integer")
+			}
+		}
+	default:
+		violation("label", fmt.Sprintf("%d is not a CIP-68 user token label", label))
+	}
+
+	return errors.Join(errs...)
+}
+
+// CIP67Prefix returns the hex encoded asset name prefix of a CIP-67 label,
+// e.g. "000de140" for 222.
+func CIP67Prefix(label int) string {
+	l := uint16(label)
+	crc := crc8([]byte{byte(l >> 8), byte(l)})
+	v := uint32(l)<<12 | uint32(crc)<<4
+	return fmt.Sprintf("%08x", v)
+}
+
+// ParseCIP67Label splits a hex encoded asset name into its CIP-67 label and
+// the rest of the name. ok is false when the name has no valid label.
+func ParseCIP67Label(assetName string) (label int, rest string, ok bool) {
+	if len(assetName) < 8 {
+		return 0, "", false
+	}
+	v, err := strconv.ParseUint(assetName[:8], 16, 32)
+	if err != nil || v&0xf000000f != 0 {
+		return 0, "", false
+	}
+	label = int(v >> 12 & 0xffff)
+	if CIP67Prefix(label) != strings.ToLower(assetName[:8]) {
+		return 0, "", false
+	}
+	return label, assetName[8:], true
+}
+
+// CIP68ReferenceAssetName returns the hex encoded name of the (100)
+// reference token of a (222), (333) or (444) user token, in lower case.
+func CIP68ReferenceAssetName(userAssetName string) (string, error) {
+	label, rest, ok := ParseCIP67Label(userAssetName)
+	if !ok {
+		return "", fmt.Errorf("cip68: %q has no CIP-67 label", userAssetName)
+	}
+	switch label {
+	case CIP68NFTLabel, CIP68FTLabel, CIP68RFTLabel:
+		return CIP67Prefix(CIP68ReferenceLabel) + strings.ToLower(rest), nil
+	}
+	return "", fmt.Errorf("cip68: label %d is not a user token label", label)
+}
+
+// ResolveCIP68 finds the (100) reference token of a CIP-68 user token and
+// decodes the metadata held in its inline datum. assetName is hex encoded.
+func ResolveCIP68(ctx context.Context, client APIClient, policyID, assetName string) (CIP68Metadata, error) {
+	// The API returns policy IDs and asset names in lower case hex.
+	policyID = strings.ToLower(policyID)
+	refName, err := CIP68ReferenceAssetName(assetName)
+	if err != nil {
+		return CIP68Metadata{}, err
+	}
+
+	// The last transaction moving the reference token holds its current
+	// datum in one of its outputs.
+	txs, err := client.AssetTransactions(ctx, policyID, refName, PageOptions{Size: 1, Order: OrderDesc})
+	if err != nil {
+		return CIP68Metadata{}, err
+	}
+	if len(txs.Data) == 0 {
+		return CIP68Metadata{}, ErrCIP68ReferenceNotFound
+	}
+
+	utxos, err := client.TransactionUTXOs(ctx, txs.Data[0].TxHash)
+	if err != nil {
+		return CIP68Metadata{}, err
+	}
+
+	for _, out := range utxos.Outputs {
+		if out.MultiAsset().Quantity(policyID, refName).IsZero() {
+			continue
+		}
+
+		datum := out.InlineDatum.Value
+		if datum == nil && out.InlineDatum.ValueRaw != "" {
+			if datum, err = out.InlineDatum.DecodeRaw(); err != nil {
+				return CIP68Metadata{}, err
+			}
+		}
+		if datum == nil {
+			return CIP68Metadata{}, fmt.Errorf("%w: reference token output has no inline datum", ErrCIP68ReferenceNotFound)
+		}
+		return ParseCIP68(datum)
+	}
+
+	return CIP68Metadata{}, ErrCIP68ReferenceNotFound
+}
+
+// crc8 computes the CRC-8 checksum (polynomial 0x07) used by CIP-67.
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
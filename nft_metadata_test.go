@@ -0,0 +1,336 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCIP67Label(t *testing.T) {
+	tests := []struct {
+		label  int
+		prefix string
+	}{
+		{0, "00000000"},
+		{1, "00001070"},
+		{23, "00017650"},
+		{100, "000643b0"},
+		{222, "000de140"},
+		{333, "0014df10"},
+		{444, "001bc280"},
+		{65535, "0ffff240"},
+	}
+	for _, tt := range tests {
+		if got := CIP67Prefix(tt.label); got != tt.prefix {
+			t.Errorf("CIP67Prefix(%d) = %s, want %s", tt.label, got, tt.prefix)
+		}
+		label, rest, ok := ParseCIP67Label(tt.prefix + "cafe")
+		if !ok || label != tt.label || rest != "cafe" {
+			t.Errorf("ParseCIP67Label(%scafe) = %d, %q, %v", tt.prefix, label, rest, ok)
+		}
+	}
+
+	for _, name := range []string{
+		"",
+		"000de14",      // too short
+		"000de150",     // wrong checksum
+		"100de140",     // first nibble set
+		"000de141",     // last nibble set
+		"zz0de140",     // not hex
+		"504154415445", // plain name
+	} {
+		if label, _, ok := ParseCIP67Label(name); ok {
+			t.Errorf("ParseCIP67Label(%q) = %d, want no label", name, label)
+		}
+	}
+	if label, _, ok := ParseCIP67Label("000DE140ABCD"); !ok || label != CIP68NFTLabel {
+		t.Errorf("ParseCIP67Label(upper case) = %d, %v, want 222", label, ok)
+	}
+}
+
+func TestCIP68ReferenceAssetName(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  string
+	}{
+		{"000de140abcd", "000643b0abcd"},
+		{"0014df10abcd", "000643b0abcd"},
+		{"001bc280abcd", "000643b0abcd"},
+		{"000DE140ABCD", "000643b0abcd"},
+	}
+	for _, tt := range tests {
+		ref, err := CIP68ReferenceAssetName(tt.name)
+		if err != nil || ref != tt.ref {
+			t.Errorf("CIP68ReferenceAssetName(%s) = %s, %v, want %s", tt.name, ref, err, tt.ref)
+		}
+	}
+
+	for _, name := range []string{"000643b0abcd", "abcd", "00001070abcd"} {
+		if ref, err := CIP68ReferenceAssetName(name); err == nil {
+			t.Errorf("CIP68ReferenceAssetName(%s) = %s, want error", name, ref)
+		}
+	}
+}
+
+func TestMetadataStringJSON(t *testing.T) {
+	var s MetadataString
+	if err := json.Unmarshal([]byte(`["ipfs://","Qm123"]`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if len(s) != 2 || s.String() != "ipfs://Qm123" {
+		t.Fatalf("chunks = %q", s)
+	}
+	if b, _ := json.Marshal(s); string(b) != `["ipfs://","Qm123"]` {
+		t.Errorf("MarshalJSON = %s", b)
+	}
+
+	if err := json.Unmarshal([]byte(`"ipfs://Qm123"`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if len(s) != 1 || s.String() != "ipfs://Qm123" {
+		t.Fatalf("string = %q", s)
+	}
+	if b, _ := json.Marshal(s); string(b) != `"ipfs://Qm123"` {
+		t.Errorf("MarshalJSON = %s", b)
+	}
+
+	if err := json.Unmarshal([]byte(`42`), &s); err == nil {
+		t.Error("UnmarshalJSON(42) succeeded")
+	}
+}
+
+// violationFields returns the fields of the violations joined in err.
+func violationFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var fields []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var v *MetadataViolation
+		if !errors.As(e, &v) {
+			t.Fatalf("%v is not a *MetadataViolation", e)
+		}
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func TestCIP25(t *testing.T) {
+	m, err := ParseCIP25([]byte(`{
+		"name": "SpaceBud #1",
+		"image": ["ipfs://QmXYZ", "abc"],
+		"mediaType": "image/png",
+		"files": [{"name": "hi-res", "mediaType": "image/png", "src": "ipfs://QmHiRes"}],
+		"traits": ["Star"]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "SpaceBud #1" || m.Image.String() != "ipfs://QmXYZabc" || len(m.Files) != 1 {
+		t.Fatalf("ParseCIP25 = %+v", m)
+	}
+	if string(m.Extra["traits"]) != `["Star"]` || len(m.Extra) != 1 {
+		t.Errorf("Extra = %v", m.Extra)
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("Validate = %v", err)
+	}
+
+	long := strings.Repeat("a", cip25ChunkSize+1)
+	tests := []struct {
+		name  string
+		json  string
+		field string
+	}{
+		{"no name", `{"image":"ipfs://x"}`, "name"},
+		{"no image", `{"name":"x"}`, "image"},
+		{"empty image", `{"name":"x","image":[""]}`, "image"},
+		{"long image chunk", `{"name":"x","image":["ipfs://","` + long + `"]}`, "image[1]"},
+		{"media type", `{"name":"x","image":"i","mediaType":"text/plain"}`, "mediaType"},
+		{"long description", `{"name":"x","image":"i","description":"` + long + `"}`, "description[0]"},
+		{"file media type", `{"name":"x","image":"i","files":[{"src":"s"}]}`, "files[0].mediaType"},
+		{"file src", `{"name":"x","image":"i","files":[{"mediaType":"image/png"}]}`, "files[0].src"},
+		{"long file src", `{"name":"x","image":"i","files":[{"mediaType":"image/png","src":"` + long + `"}]}`, "files[0].src[0]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseCIP25([]byte(tt.json))
+			if err != nil {
+				t.Fatal(err)
+			}
+			fields := violationFields(t, m.Validate())
+			if len(fields) != 1 || fields[0] != tt.field {
+				t.Errorf("violations %v, want [%s]", fields, tt.field)
+			}
+		})
+	}
+
+	if _, err := (AssetInfo{}).CIP25(); err == nil {
+		t.Error("CIP25 without metadata succeeded")
+	}
+	if _, err := ParseCIP25([]byte(`{"name":1}`)); err == nil {
+		t.Error("ParseCIP25 with a numeric name succeeded")
+	}
+}
+
+func text(s string) PlutusData {
+	return PlutusBytes(s)
+}
+
+func integer(n int64) PlutusData {
+	return PlutusInteger{Value: big.NewInt(n)}
+}
+
+// cip68Datum builds Constr 0 [metadata, version, extra].
+func cip68Datum(version int64, fields ...PlutusPair) PlutusConstr {
+	return PlutusConstr{Fields: []PlutusData{PlutusMap(fields), integer(version), PlutusConstr{}}}
+}
+
+func entry(key string, value PlutusData) PlutusPair {
+	return PlutusPair{Key: text(key), Value: value}
+}
+
+func TestParseCIP68(t *testing.T) {
+	m, err := ParseCIP68(cip68Datum(1,
+		entry("name", text("Bud")),
+		entry("image", PlutusList{text("ipfs://"), text("QmXYZ")}),
+		entry("mediaType", text("image/png")),
+		entry("decimals", integer(6)),
+		entry("files", PlutusList{PlutusMap{
+			entry("mediaType", text("image/png")),
+			entry("src", text("ipfs://QmHiRes")),
+		}}),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Version != 1 || m.Name != "Bud" || m.Image != "ipfs://QmXYZ" || m.Decimals != 6 {
+		t.Errorf("ParseCIP68 = %+v", m)
+	}
+	if len(m.Files) != 1 || m.Files[0].Src.String() != "ipfs://QmHiRes" || m.Files[0].MediaType != "image/png" {
+		t.Errorf("Files = %+v", m.Files)
+	}
+	if _, ok := m.Extra.(PlutusConstr); !ok {
+		t.Errorf("Extra = %#v", m.Extra)
+	}
+
+	for name, d := range map[string]PlutusData{
+		"not a constructor": PlutusList{},
+		"constructor 1":     PlutusConstr{Constructor: 1, Fields: []PlutusData{PlutusMap{}, integer(1)}},
+		"missing version":   PlutusConstr{Fields: []PlutusData{PlutusMap{}}},
+		"metadata list":     PlutusConstr{Fields: []PlutusData{PlutusList{}, integer(1)}},
+		"version bytes":     PlutusConstr{Fields: []PlutusData{PlutusMap{}, text("1")}},
+		"integer key":       PlutusConstr{Fields: []PlutusData{PlutusMap{{Key: integer(1), Value: text("x")}}, integer(1)}},
+	} {
+		if _, err := ParseCIP68(d); err == nil {
+			t.Errorf("ParseCIP68(%s) succeeded", name)
+		}
+	}
+}
+
+func TestCIP68Validate(t *testing.T) {
+	nft := []PlutusPair{entry("name", text("Bud")), entry("image", text("ipfs://x"))}
+	ft := []PlutusPair{entry("name", text("Coin")), entry("description", text("A coin")), entry("decimals", integer(6))}
+
+	tests := []struct {
+		name   string
+		label  int
+		datum  PlutusConstr
+		fields []string
+	}{
+		{"nft", CIP68NFTLabel, cip68Datum(1, nft...), nil},
+		{"rft", CIP68RFTLabel, cip68Datum(2, nft...), nil},
+		{"ft", CIP68FTLabel, cip68Datum(1, ft...), nil},
+		{"version", CIP68NFTLabel, cip68Datum(0, nft...), []string{"version"}},
+		{"nft name", CIP68NFTLabel, cip68Datum(1, nft[1]), []string{"name"}},
+		{"nft image", CIP68NFTLabel, cip68Datum(1, nft[0]), []string{"image"}},
+		{"nft image not bytes", CIP68NFTLabel, cip68Datum(1, nft[0], entry("image", integer(1))), []string{"image"}},
+		{"nft invalid utf-8", CIP68NFTLabel, cip68Datum(1, entry("name", PlutusBytes{0xff}), nft[1]), []string{"name"}},
+		{"nft media type", CIP68NFTLabel, cip68Datum(1, append(nft, entry("mediaType", text("text/html")))...), []string{"mediaType"}},
+		{"nft file", CIP68NFTLabel, cip68Datum(1, append(nft, entry("files", PlutusList{PlutusMap{}}))...), []string{"files[0].mediaType", "files[0].src"}},
+		{"ft description", CIP68FTLabel, cip68Datum(1, ft[0], ft[2]), []string{"description"}},
+		{"ft decimals", CIP68FTLabel, cip68Datum(1, ft[0], ft[1], entry("decimals", text("6"))), []string{"decimals"}},
+		{"reference label", CIP68ReferenceLabel, cip68Datum(1, nft...), []string{"label"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseCIP68(tt.datum)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fields := violationFields(t, m.Validate(tt.label))
+			if fmt.Sprint(fields) != fmt.Sprint(tt.fields) {
+				t.Errorf("violations %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestResolveCIP68(t *testing.T) {
+	const (
+		policy  = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+		refName = "000643b0abcd"
+		txHash  = "8f3a"
+	)
+	datum, err := json.Marshal(cip68Datum(1, entry("name", text("Bud")), entry("image", text("ipfs://x"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch strings.ToLower(r.URL.Path) {
+		case "/app/v1/assets/" + policy + "/" + refName + "/transactions":
+			w.Write([]byte(`{"data":[{"tx_hash":"` + txHash + `"}],"cursor":null}`))
+		case "/app/v1/transactions/" + txHash + "/utxos":
+			fmt.Fprintf(w, `{"hash":%q,"outputs":[
+				{"index":0,"value":"1000000","assets":[]},
+				{"index":1,"value":"2000000","assets":[{"policy_id":%q,"asset_name":%q,"quantity":"1"}],
+				 "inline_datum":{"value":%s}}
+			]}`, txHash, policy, refName, datum)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+	ctx := context.Background()
+
+	for _, tt := range []struct{ policy, name string }{
+		{policy, "000de140abcd"},
+		{policy, "000DE140ABCD"},
+		{strings.ToUpper(policy), "000de140ABCD"},
+	} {
+		paths = nil
+		m, err := ResolveCIP68(ctx, client, tt.policy, tt.name)
+		if err != nil {
+			t.Errorf("ResolveCIP68(%s, %s): %v", tt.policy, tt.name, err)
+			continue
+		}
+		if m.Name != "Bud" || m.Image != "ipfs://x" {
+			t.Errorf("ResolveCIP68(%s, %s) = %+v", tt.policy, tt.name, m)
+		}
+		for _, p := range paths {
+			if p != strings.ToLower(p) {
+				t.Errorf("requested %s, want lower case hex", p)
+			}
+		}
+	}
+
+	if _, err := ResolveCIP68(ctx, client, policy, "000de140ffff"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unknown reference token: err = %v, want ErrNotFound", err)
+	}
+	if _, err := ResolveCIP68(ctx, client, policy, "504154415445"); err == nil {
+		t.Error("ResolveCIP68 without a CIP-67 label succeeded")
+	}
+}
@@ -0,0 +1,81 @@
+package tangocrypto_go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Int64 is an integer that the API returns either as a JSON number or as a
+// numeric string.
+type Int64 int64
+
+func (n *Int64) UnmarshalJSON(data []byte) error {
+	s, ok, err := numericJSON(data)
+	if err != nil || !ok {
+		*n = 0
+		return err
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("int64: %w", err)
+	}
+	*n = Int64(v)
+	return nil
+}
+
+// NullSlot is an optional absolute slot number, such as the bounds of a
+// transaction validity interval. Valid is false when the bound is not set.
+type NullSlot struct {
+	Slot  uint64
+	Valid bool
+}
+
+func (s *NullSlot) UnmarshalJSON(data []byte) error {
+	str, ok, err := numericJSON(data)
+	if err != nil || !ok {
+		*s = NullSlot{}
+		return err
+	}
+
+	v, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("slot: %w", err)
+	}
+	*s = NullSlot{Slot: v, Valid: true}
+	return nil
+}
+
+func (s NullSlot) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendUint(nil, s.Slot, 10), nil
+}
+
+// numericJSON returns the digits of a JSON number or numeric string. ok is
+// false for null and empty strings.
+func numericJSON(data []byte) (s string, ok bool, err error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return "", false, nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		if err = json.Unmarshal(data, &s); err != nil {
+			return "", false, err
+		}
+		if s == "" {
+			return "", false, nil
+		}
+		return s, true, nil
+	}
+
+	var n json.Number
+	if err = json.Unmarshal(data, &n); err != nil {
+		return "", false, fmt.Errorf("unexpected numeric value %s", data)
+	}
+	return n.String(), true, nil
+}
@@ -0,0 +1,210 @@
+package tangocrypto_go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// Order is the sort order of a paginated listing.
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Cursor is an opaque position in a paginated listing. The API returns it as
+// a string, a number or null; the empty Cursor marks the last page.
+type Cursor string
+
+func (c *Cursor) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*c = ""
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*c = Cursor(s)
+	default:
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("cursor: unexpected value %s", data)
+		}
+		*c = Cursor(n.String())
+	}
+	return nil
+}
+
+func (c Cursor) MarshalJSON() ([]byte, error) {
+	if c == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(c))
+}
+
+// PageOptions holds the query parameters shared by every list endpoint.
+type PageOptions struct {
+	// Size is the number of items per page. The API default is used when zero.
+	Size int
+	// Order is the sort order. The API default is used when empty.
+	Order Order
+	// Cursor is the position to resume from, as returned in Page.Cursor.
+	Cursor Cursor
+}
+
+func (o PageOptions) encode(q url.Values) url.Values {
+	if q == nil {
+		q = url.Values{}
+	}
+	if o.Size > 0 {
+		q.Set("size", strconv.Itoa(o.Size))
+	}
+	if o.Order != "" {
+		q.Set("order", string(o.Order))
+	}
+	if o.Cursor != "" {
+		q.Set("cursor", string(o.Cursor))
+	}
+	return q
+}
+
+// ErrCursorNotAdvancing is returned by Pager when the API returns the cursor
+// it was given, which would otherwise fetch the same page forever.
+var ErrCursorNotAdvancing = errors.New("tangocrypto: pagination cursor did not advance")
+
+// Page is a single page of a paginated listing.
+type Page[T any] struct {
+	Data   []T    `json:"data"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// HasMore reports whether another page follows this one.
+func (p Page[T]) HasMore() bool {
+	return p.Cursor != ""
+}
+
+// PageFunc fetches the page described by opts.
+type PageFunc[T any] func(ctx context.Context, opts PageOptions) (Page[T], error)
+
+// Pager walks every item of a paginated listing, following the cursor until
+// the last page.
+//
+//	p := NewPager(fetch, PageOptions{Size: 100})
+//	for p.Next(ctx) {
+//		item := p.Item()
+//		...
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+//
+// or, using range-over-func:
+//
+//	for item, err := range p.All(ctx) {
+//		...
+//	}
+type Pager[T any] struct {
+	fetch PageFunc[T]
+	opts  PageOptions
+
+	page    []T
+	idx     int
+	current T
+	done    bool
+	err     error
+}
+
+// NewPager returns a Pager reading pages from fetch. opts.Cursor, if set, is
+// used as the starting position.
+func NewPager[T any](fetch PageFunc[T], opts PageOptions) *Pager[T] {
+	return &Pager[T]{
+		fetch: fetch,
+		opts:  opts,
+	}
+}
+
+// Next advances the pager to the next item, fetching a new page when the
+// current one is exhausted. It returns false when there are no more items or
+// an error occurred.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	for p.idx >= len(p.page) {
+		if p.done || p.err != nil {
+			return false
+		}
+
+		page, err := p.fetch(ctx, p.opts)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		if page.HasMore() && page.Cursor == p.opts.Cursor {
+			p.err = fmt.Errorf("%w: %s", ErrCursorNotAdvancing, page.Cursor)
+			return false
+		}
+
+		p.page, p.idx = page.Data, 0
+		p.opts.Cursor = page.Cursor
+		if !page.HasMore() {
+			p.done = true
+		}
+	}
+
+	p.current = p.page[p.idx]
+	p.idx++
+	return true
+}
+
+// Item returns the item at the current position of the pager.
+func (p *Pager[T]) Item() T {
+	return p.current
+}
+
+// Err returns the first error encountered while paging.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Cursor returns the cursor of the page that will be fetched next. It can be
+// stored to resume iteration later, but only at a page boundary: the items
+// of the current page not yet returned by Next, see Buffered, are skipped
+// when resuming from it.
+func (p *Pager[T]) Cursor() Cursor {
+	return p.opts.Cursor
+}
+
+// Buffered returns the number of items of the current page not yet returned
+// by Next. Cursor is a safe resume point when it is zero.
+func (p *Pager[T]) Buffered() int {
+	return len(p.page) - p.idx
+}
+
+// All returns an iterator over the remaining items. An error stops the
+// iteration after being yielded with the zero value of T.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next(ctx) {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// getPage fetches a single page of a list endpoint.
+func getPage[T any](ctx context.Context, c *apiClient, opts PageOptions, query url.Values, path ...string) (page Page[T], err error) {
+	err = c.getJSON(ctx, opts.encode(query), &page, path...)
+	return page, err
+}
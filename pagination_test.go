@@ -0,0 +1,105 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// pages serves the given pages in order, keyed by the cursor that requests
+// them. The first page is requested with an empty cursor.
+func pages[T any](t *testing.T, list ...Page[T]) (PageFunc[T], *[]Cursor) {
+	t.Helper()
+	var requested []Cursor
+	byCursor := map[Cursor]Page[T]{}
+	var prev Cursor
+	for _, p := range list {
+		byCursor[prev] = p
+		prev = p.Cursor
+	}
+	fetch := func(ctx context.Context, opts PageOptions) (Page[T], error) {
+		requested = append(requested, opts.Cursor)
+		if len(requested) > 10 {
+			t.Fatalf("too many requests: %v", requested)
+		}
+		p, ok := byCursor[opts.Cursor]
+		if !ok {
+			t.Fatalf("unexpected cursor %q", opts.Cursor)
+		}
+		return p, nil
+	}
+	return fetch, &requested
+}
+
+func TestPager(t *testing.T) {
+	fetch, requested := pages(t,
+		Page[int]{Data: []int{1, 2}, Cursor: "a"},
+		Page[int]{Data: nil, Cursor: "b"},
+		Page[int]{Data: []int{3}},
+	)
+	p := NewPager(fetch, PageOptions{})
+
+	var got []int
+	for p.Next(context.Background()) {
+		got = append(got, p.Item())
+	}
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("items = %v, want [1 2 3]", got)
+	}
+	if !slices.Equal(*requested, []Cursor{"", "a", "b"}) {
+		t.Errorf("requested cursors %v, want [ a b]", *requested)
+	}
+}
+
+func TestPagerCursorNotAdvancing(t *testing.T) {
+	for _, data := range [][]int{nil, {1}} {
+		fetch, requested := pages(t,
+			Page[int]{Data: []int{0}, Cursor: "a"},
+			Page[int]{Data: data, Cursor: "a"},
+		)
+		p := NewPager(fetch, PageOptions{})
+
+		for p.Next(context.Background()) {
+		}
+		if err := p.Err(); !errors.Is(err, ErrCursorNotAdvancing) {
+			t.Errorf("data %v: err = %v, want ErrCursorNotAdvancing", data, err)
+		}
+		if len(*requested) != 2 {
+			t.Errorf("data %v: %d requests, want 2", data, len(*requested))
+		}
+	}
+}
+
+func TestPagerResume(t *testing.T) {
+	fetch, _ := pages(t,
+		Page[int]{Data: []int{1, 2}, Cursor: "a"},
+		Page[int]{Data: []int{3}},
+	)
+	p := NewPager(fetch, PageOptions{})
+	ctx := context.Background()
+
+	p.Next(ctx)
+	if p.Buffered() != 1 || p.Cursor() != "a" {
+		t.Fatalf("after one item: Buffered %d, Cursor %q", p.Buffered(), p.Cursor())
+	}
+	p.Next(ctx)
+	if p.Buffered() != 0 {
+		t.Fatalf("after the page: Buffered %d, want 0", p.Buffered())
+	}
+
+	resumed := NewPager(fetch, PageOptions{Cursor: p.Cursor()})
+	var got []int
+	for item, err := range resumed.All(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+	if !slices.Equal(got, []int{3}) {
+		t.Errorf("resumed items = %v, want [3]", got)
+	}
+}
@@ -0,0 +1,296 @@
+package tangocrypto_go
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/ripoff2/tangocrypto-go/internal/cbor"
+)
+
+// ErrDatumHashMismatch is returned by InlineDatum.VerifyHash when the hash
+// of the raw datum differs from InlineDatum.Hash.
+var ErrDatumHashMismatch = errors.New("tangocrypto: datum hash mismatch")
+
+// ParsePlutusDataCBOR decodes Plutus data from its CBOR encoding. The result
+// is identical to ParsePlutusDataJSON applied to the same datum.
+func ParsePlutusDataCBOR(data []byte) (PlutusData, error) {
+	v, err := cbor.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("plutus data: %w", err)
+	}
+	return plutusFromCBOR(v)
+}
+
+// plutusFromCBOR converts a decoded CBOR item into Plutus data.
+// Constructors 0-6 use tags 121-127, 7-127 use tags 1280-1400 and the
+// others tag 102 with a [constructor, fields] array.
+func plutusFromCBOR(v interface{}) (PlutusData, error) {
+	switch v := v.(type) {
+	case uint64:
+		return PlutusInteger{Value: new(big.Int).SetUint64(v)}, nil
+	case *big.Int:
+		return PlutusInteger{Value: v}, nil
+	case []byte:
+		return PlutusBytes(v), nil
+	case []interface{}:
+		list, err := plutusListFromCBOR(v)
+		if err != nil {
+			return nil, err
+		}
+		return PlutusList(list), nil
+	case cbor.Map:
+		m := make(PlutusMap, 0, len(v))
+		for _, p := range v {
+			k, err := plutusFromCBOR(p.Key)
+			if err != nil {
+				return nil, err
+			}
+			val, err := plutusFromCBOR(p.Value)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, PlutusPair{Key: k, Value: val})
+		}
+		return m, nil
+	case cbor.Tag:
+		var constructor uint64
+		content := v.Content
+		switch {
+		case v.Number >= 121 && v.Number <= 127:
+			constructor = v.Number - 121
+		case v.Number >= 1280 && v.Number <= 1400:
+			constructor = v.Number - 1280 + 7
+		case v.Number == 102:
+			arr, ok := v.Content.([]interface{})
+			if !ok || len(arr) != 2 {
+				return nil, errors.New("plutus data: invalid general constructor")
+			}
+			c, ok := arr[0].(uint64)
+			if !ok {
+				return nil, errors.New("plutus data: invalid constructor index")
+			}
+			constructor, content = c, arr[1]
+		default:
+			return nil, fmt.Errorf("plutus data: unexpected tag %d", v.Number)
+		}
+
+		arr, ok := content.([]interface{})
+		if !ok {
+			return nil, errors.New("plutus data: constructor fields are not a list")
+		}
+		fields, err := plutusListFromCBOR(arr)
+		if err != nil {
+			return nil, err
+		}
+		return PlutusConstr{Constructor: constructor, Fields: fields}, nil
+	}
+
+	return nil, fmt.Errorf("plutus data: unexpected CBOR item %T", v)
+}
+
+func plutusListFromCBOR(items []interface{}) ([]PlutusData, error) {
+	list := make([]PlutusData, 0, len(items))
+	for _, item := range items {
+		d, err := plutusFromCBOR(item)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, nil
+}
+
+// DecodeRaw decodes the datum from ValueRaw, its hex encoded CBOR.
+func (d InlineDatum) DecodeRaw() (PlutusData, error) {
+	raw, err := hex.DecodeString(d.ValueRaw)
+	if err != nil {
+		return nil, fmt.Errorf("plutus data: invalid raw datum: %w", err)
+	}
+	return ParsePlutusDataCBOR(raw)
+}
+
+// VerifyHash checks that Hash is the blake2b-256 hash of ValueRaw. It
+// returns ErrDatumHashMismatch when they differ.
+func (d InlineDatum) VerifyHash() error {
+	raw, err := hex.DecodeString(d.ValueRaw)
+	if err != nil {
+		return fmt.Errorf("plutus data: invalid raw datum: %w", err)
+	}
+
+	sum := blake2b.Sum256(raw)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), d.Hash) {
+		return fmt.Errorf("%w: expected %s, computed %x", ErrDatumHashMismatch, d.Hash, sum)
+	}
+	return nil
+}
+
+// PlutusScript is a decoded Plutus script.
+type PlutusScript struct {
+	// Version is the Plutus language version, 1 for PlutusV1.
+	Version int
+	// Program is the flat encoded UPLC program.
+	Program []byte
+}
+
+// Native script types, as used by cardano-cli.
+const (
+	NativeScriptSig     = "sig"
+	NativeScriptAll     = "all"
+	NativeScriptAny     = "any"
+	NativeScriptAtLeast = "atLeast"
+	NativeScriptAfter   = "after"
+	NativeScriptBefore  = "before"
+)
+
+// NativeScript is a decoded native (timelock) script. Its JSON form is the
+// one used by cardano-cli.
+type NativeScript struct {
+	Type     string         `json:"type"`
+	KeyHash  string         `json:"keyHash,omitempty"`
+	Required int            `json:"required,omitempty"`
+	Scripts  []NativeScript `json:"scripts,omitempty"`
+	Slot     uint64         `json:"slot,omitempty"`
+}
+
+// PlutusVersion returns the Plutus language version of the script, or 0 for
+// native scripts.
+func (s Script) PlutusVersion() int {
+	switch strings.ToLower(s.Type) {
+	case "plutusv1":
+		return 1
+	case "plutusv2":
+		return 2
+	case "plutusv3":
+		return 3
+	}
+	return 0
+}
+
+// PlutusScript decodes Code as a Plutus script.
+func (s Script) PlutusScript() (PlutusScript, error) {
+	version := s.PlutusVersion()
+	if version == 0 {
+		return PlutusScript{}, fmt.Errorf("script: %s is not a Plutus script", s.Type)
+	}
+
+	code, err := hex.DecodeString(s.Code)
+	if err != nil {
+		return PlutusScript{}, fmt.Errorf("script: invalid code: %w", err)
+	}
+
+	// The flat program is wrapped in one or two CBOR byte strings.
+	program := code
+	for i := 0; i < 2; i++ {
+		v, err := cbor.Unmarshal(program)
+		if err != nil {
+			break
+		}
+		b, ok := v.([]byte)
+		if !ok {
+			break
+		}
+		program = b
+	}
+	if len(program) == len(code) {
+		return PlutusScript{}, errors.New("script: code is not a CBOR byte string")
+	}
+
+	return PlutusScript{Version: version, Program: program}, nil
+}
+
+// NativeScript decodes Code as a native script.
+func (s Script) NativeScript() (NativeScript, error) {
+	if s.PlutusVersion() != 0 {
+		return NativeScript{}, fmt.Errorf("script: %s is not a native script", s.Type)
+	}
+
+	code, err := hex.DecodeString(s.Code)
+	if err != nil {
+		return NativeScript{}, fmt.Errorf("script: invalid code: %w", err)
+	}
+	return ParseNativeScriptCBOR(code)
+}
+
+// ParseNativeScriptCBOR decodes a native script from its CBOR encoding.
+func ParseNativeScriptCBOR(data []byte) (NativeScript, error) {
+	v, err := cbor.Unmarshal(data)
+	if err != nil {
+		return NativeScript{}, fmt.Errorf("script: %w", err)
+	}
+	return nativeScriptFromCBOR(v)
+}
+
+func nativeScriptFromCBOR(v interface{}) (NativeScript, error) {
+	invalid := errors.New("script: invalid native script")
+
+	arr, ok := v.([]interface{})
+	if !ok || len(arr) < 2 {
+		return NativeScript{}, invalid
+	}
+	kind, ok := arr[0].(uint64)
+	if !ok {
+		return NativeScript{}, invalid
+	}
+
+	scripts := func(v interface{}) ([]NativeScript, error) {
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, invalid
+		}
+		out := make([]NativeScript, 0, len(items))
+		for _, item := range items {
+			s, err := nativeScriptFromCBOR(item)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, s)
+		}
+		return out, nil
+	}
+
+	switch {
+	case kind == 0 && len(arr) == 2:
+		keyHash, ok := arr[1].([]byte)
+		if !ok {
+			return NativeScript{}, invalid
+		}
+		return NativeScript{Type: NativeScriptSig, KeyHash: hex.EncodeToString(keyHash)}, nil
+	case (kind == 1 || kind == 2) && len(arr) == 2:
+		s, err := scripts(arr[1])
+		if err != nil {
+			return NativeScript{}, err
+		}
+		t := NativeScriptAll
+		if kind == 2 {
+			t = NativeScriptAny
+		}
+		return NativeScript{Type: t, Scripts: s}, nil
+	case kind == 3 && len(arr) == 3:
+		n, ok := arr[1].(uint64)
+		if !ok {
+			return NativeScript{}, invalid
+		}
+		s, err := scripts(arr[2])
+		if err != nil {
+			return NativeScript{}, err
+		}
+		return NativeScript{Type: NativeScriptAtLeast, Required: int(n), Scripts: s}, nil
+	case (kind == 4 || kind == 5) && len(arr) == 2:
+		slot, ok := arr[1].(uint64)
+		if !ok {
+			return NativeScript{}, invalid
+		}
+		t := NativeScriptAfter
+		if kind == 5 {
+			t = NativeScriptBefore
+		}
+		return NativeScript{Type: t, Slot: slot}, nil
+	}
+
+	return NativeScript{}, invalid
+}
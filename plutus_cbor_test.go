@@ -0,0 +1,206 @@
+package tangocrypto_go
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePlutusDataCBORMatchesJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		cbor string
+		json string
+	}{
+		{"unit", "d87980", `{"constructor":0,"fields":[]}`},
+		{"constructor 6", "d87f80", `{"constructor":6,"fields":[]}`},
+		{"constructor 7", "d9050001", ``},
+		{"constructor 7 fields", "d9050081 01", `{"constructor":7,"fields":[{"int":1}]}`},
+		{"constructor 127", "d9057880", `{"constructor":127,"fields":[]}`},
+		{"general constructor", "d866821903e880", `{"constructor":1000,"fields":[]}`},
+		{"indefinite fields", "d8799f4201ff1864ff", `{"constructor":0,"fields":[{"bytes":"01ff"},{"int":100}]}`},
+		{"negative int", "20", `{"int":-1}`},
+		{"bignum", "c249010000000000000000", `{"int":18446744073709551616}`},
+		{"negative bignum", "c349010000000000000000", `{"int":-18446744073709551617}`},
+		{"list", "83010203", `{"list":[{"int":1},{"int":2},{"int":3}]}`},
+		{"map", "a2410101420203d87980", `{"map":[{"k":{"bytes":"01"},"v":{"int":1}},{"k":{"bytes":"0203"},"v":{"constructor":0,"fields":[]}}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := hex.DecodeString(strings.ReplaceAll(tt.cbor, " ", ""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			fromCBOR, err := ParsePlutusDataCBOR(raw)
+			if tt.json == "" {
+				if err == nil {
+					t.Fatalf("ParsePlutusDataCBOR(%s) = %v, want error", tt.cbor, fromCBOR)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePlutusDataCBOR(%s): %v", tt.cbor, err)
+			}
+			fromJSON, err := ParsePlutusDataJSON([]byte(tt.json))
+			if err != nil {
+				t.Fatalf("ParsePlutusDataJSON(%s): %v", tt.json, err)
+			}
+
+			got, err := json.Marshal(fromCBOR)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := json.Marshal(fromJSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) || string(got) != tt.json {
+				t.Errorf("CBOR gives %s, JSON gives %s, want %s", got, want, tt.json)
+			}
+		})
+	}
+}
+
+func TestParsePlutusDataCBORInvalid(t *testing.T) {
+	for _, h := range []string{
+		"",           // empty
+		"d87a",       // truncated tag content
+		"d87901",     // constructor fields not a list
+		"d8668101",   // general constructor without fields
+		"d86682f680", // general constructor index not an integer
+		"d81e80",     // unknown tag
+		"f6",         // null
+		"6161",       // text string
+	} {
+		raw, _ := hex.DecodeString(h)
+		if d, err := ParsePlutusDataCBOR(raw); err == nil {
+			t.Errorf("ParsePlutusDataCBOR(%q) = %v, want error", h, d)
+		}
+	}
+}
+
+func TestInlineDatumVerifyHash(t *testing.T) {
+	d := InlineDatum{
+		Hash:     "923918e403bf43c34b4ef6b48eb2ee04babed17320d8d1b9ff9ad086e86f44ec",
+		ValueRaw: "d87980",
+	}
+	if err := d.VerifyHash(); err != nil {
+		t.Fatalf("VerifyHash: %v", err)
+	}
+
+	d.Hash = strings.ToUpper(d.Hash)
+	if err := d.VerifyHash(); err != nil {
+		t.Fatalf("VerifyHash with upper case hash: %v", err)
+	}
+
+	d.ValueRaw = "d87a80"
+	if err := d.VerifyHash(); !errors.Is(err, ErrDatumHashMismatch) {
+		t.Fatalf("VerifyHash = %v, want ErrDatumHashMismatch", err)
+	}
+
+	d.ValueRaw = "zz"
+	if err := d.VerifyHash(); err == nil || errors.Is(err, ErrDatumHashMismatch) {
+		t.Fatalf("VerifyHash with invalid hex = %v, want decoding error", err)
+	}
+}
+
+func TestInlineDatumDecodeRaw(t *testing.T) {
+	d := InlineDatum{ValueRaw: "d8799f4201ff1864ff"}
+	v, err := d.DecodeRaw()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := v.(PlutusConstr)
+	if !ok || c.Constructor != 0 || len(c.Fields) != 2 {
+		t.Fatalf("DecodeRaw = %#v", v)
+	}
+	if b, ok := c.Fields[0].(PlutusBytes); !ok || hex.EncodeToString(b) != "01ff" {
+		t.Fatalf("field 0 = %#v, want bytes 01ff", c.Fields[0])
+	}
+	if i, ok := c.Fields[1].(PlutusInteger); !ok || i.Value.Int64() != 100 {
+		t.Fatalf("field 1 = %#v, want 100", c.Fields[1])
+	}
+}
+
+func TestScriptPlutusScript(t *testing.T) {
+	program, _ := hex.DecodeString("01000033222220051200120011")
+
+	tests := []struct {
+		name    string
+		script  Script
+		version int
+	}{
+		{"double wrapped", Script{Type: "plutusV1", Code: "4e4d01000033222220051200120011"}, 1},
+		{"single wrapped", Script{Type: "plutusV2", Code: "4d01000033222220051200120011"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := tt.script.PlutusScript()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Version != tt.version || !reflect.DeepEqual(s.Program, program) {
+				t.Errorf("PlutusScript = {%d %x}, want {%d %x}", s.Version, s.Program, tt.version, program)
+			}
+		})
+	}
+
+	for _, s := range []Script{
+		{Type: "timelock", Code: "8200581c00000000000000000000000000000000000000000000000000000000"},
+		{Type: "plutusV1", Code: "01000033"},
+		{Type: "plutusV1", Code: "zz"},
+	} {
+		if _, err := s.PlutusScript(); err == nil {
+			t.Errorf("PlutusScript(%s %s) succeeded, want error", s.Type, s.Code)
+		}
+	}
+}
+
+func TestScriptNativeScript(t *testing.T) {
+	keyHash := "e09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a"
+	s := Script{
+		Type: "timelock",
+		Code: "8201838200581c" + keyHash + "82041864" + "83030181" + "8205190100",
+	}
+	got, err := s.NativeScript()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NativeScript{
+		Type: NativeScriptAll,
+		Scripts: []NativeScript{
+			{Type: NativeScriptSig, KeyHash: keyHash},
+			{Type: NativeScriptAfter, Slot: 100},
+			{Type: NativeScriptAtLeast, Required: 1, Scripts: []NativeScript{{Type: NativeScriptBefore, Slot: 256}}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("NativeScript = %+v, want %+v", got, want)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const wantJSON = `{"type":"all","scripts":[{"type":"sig","keyHash":"` +
+		"e09d36c79dec9bd1b3d9e152247701cd0bb860b5ebfd1de8abb6735a" +
+		`"},{"type":"after","slot":100},{"type":"atLeast","required":1,"scripts":[{"type":"before","slot":256}]}]}`
+	if string(b) != wantJSON {
+		t.Errorf("JSON = %s, want %s", b, wantJSON)
+	}
+
+	for _, s := range []Script{
+		{Type: "plutusV2", Code: "4d01000033222220051200120011"},
+		{Type: "timelock", Code: "820101"},     // [1, 1]: scripts not a list
+		{Type: "timelock", Code: "8300401864"}, // [0, h'', 100]: extra item
+		{Type: "timelock", Code: "820600"},     // unknown script type
+	} {
+		if _, err := s.NativeScript(); err == nil {
+			t.Errorf("NativeScript(%s %s) succeeded, want error", s.Type, s.Code)
+		}
+	}
+}
@@ -0,0 +1,536 @@
+package tangocrypto_go
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxPlutusDepth bounds the nesting of decoded Plutus data.
+const maxPlutusDepth = 256
+
+// PlutusData is a Plutus data value. It is one of PlutusConstr, PlutusMap,
+// PlutusList, PlutusInteger or PlutusBytes.
+type PlutusData interface {
+	isPlutusData()
+}
+
+// PlutusConstr is a constructor application, the encoding of Haskell sum and
+// product types.
+type PlutusConstr struct {
+	Constructor uint64
+	Fields      []PlutusData
+}
+
+// PlutusMap is an association list. Entries are kept in encoding order.
+type PlutusMap []PlutusPair
+
+// PlutusPair is an entry of a PlutusMap.
+type PlutusPair struct {
+	Key   PlutusData
+	Value PlutusData
+}
+
+// PlutusList is a list of Plutus data.
+type PlutusList []PlutusData
+
+// PlutusInteger is an arbitrary precision integer.
+type PlutusInteger struct {
+	Value *big.Int
+}
+
+// PlutusBytes is a byte string.
+type PlutusBytes []byte
+
+func (PlutusConstr) isPlutusData()  {}
+func (PlutusMap) isPlutusData()     {}
+func (PlutusList) isPlutusData()    {}
+func (PlutusInteger) isPlutusData() {}
+func (PlutusBytes) isPlutusData()   {}
+
+// ParsePlutusDataJSON decodes Plutus data in the detailed JSON schema, e.g.
+//
+//	{"constructor": 0, "fields": [{"int": 42}, {"bytes": "cafe"}]}
+func ParsePlutusDataJSON(data []byte) (PlutusData, error) {
+	return parsePlutusJSON(data, 0)
+}
+
+func parsePlutusJSON(data []byte, depth int) (PlutusData, error) {
+	if depth > maxPlutusDepth {
+		return nil, errors.New("plutus data: maximum nesting depth exceeded")
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("plutus data: %w", err)
+	}
+
+	switch {
+	case obj["constructor"] != nil:
+		var c uint64
+		if err := json.Unmarshal(obj["constructor"], &c); err != nil {
+			return nil, fmt.Errorf("plutus data: invalid constructor: %w", err)
+		}
+		fields, err := parsePlutusJSONList(obj["fields"], depth)
+		if err != nil {
+			return nil, err
+		}
+		return PlutusConstr{Constructor: c, Fields: fields}, nil
+
+	case obj["map"] != nil:
+		var entries []struct {
+			K json.RawMessage `json:"k"`
+			V json.RawMessage `json:"v"`
+		}
+		if err := json.Unmarshal(obj["map"], &entries); err != nil {
+			return nil, fmt.Errorf("plutus data: invalid map: %w", err)
+		}
+		m := make(PlutusMap, 0, len(entries))
+		for _, e := range entries {
+			k, err := parsePlutusJSON(e.K, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			v, err := parsePlutusJSON(e.V, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, PlutusPair{Key: k, Value: v})
+		}
+		return m, nil
+
+	case obj["list"] != nil:
+		list, err := parsePlutusJSONList(obj["list"], depth)
+		if err != nil {
+			return nil, err
+		}
+		return PlutusList(list), nil
+
+	case obj["int"] != nil:
+		i, ok := new(big.Int).SetString(string(bytes.TrimSpace(obj["int"])), 10)
+		if !ok {
+			return nil, fmt.Errorf("plutus data: invalid int %s", obj["int"])
+		}
+		return PlutusInteger{Value: i}, nil
+
+	case obj["bytes"] != nil:
+		var s string
+		if err := json.Unmarshal(obj["bytes"], &s); err != nil {
+			return nil, fmt.Errorf("plutus data: invalid bytes: %w", err)
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("plutus data: invalid bytes: %w", err)
+		}
+		return PlutusBytes(b), nil
+	}
+
+	return nil, fmt.Errorf("plutus data: unknown value %s", data)
+}
+
+func parsePlutusJSONList(data json.RawMessage, depth int) ([]PlutusData, error) {
+	var items []json.RawMessage
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("plutus data: invalid list: %w", err)
+		}
+	}
+
+	list := make([]PlutusData, 0, len(items))
+	for _, item := range items {
+		v, err := parsePlutusJSON(item, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+func (c PlutusConstr) MarshalJSON() ([]byte, error) {
+	fields := c.Fields
+	if fields == nil {
+		fields = []PlutusData{}
+	}
+	return json.Marshal(struct {
+		Constructor uint64       `json:"constructor"`
+		Fields      []PlutusData `json:"fields"`
+	}{c.Constructor, fields})
+}
+
+func (m PlutusMap) MarshalJSON() ([]byte, error) {
+	type entry struct {
+		K PlutusData `json:"k"`
+		V PlutusData `json:"v"`
+	}
+	entries := make([]entry, len(m))
+	for i, p := range m {
+		entries[i] = entry{p.Key, p.Value}
+	}
+	return json.Marshal(struct {
+		Map []entry `json:"map"`
+	}{entries})
+}
+
+func (l PlutusList) MarshalJSON() ([]byte, error) {
+	list := []PlutusData(l)
+	if list == nil {
+		list = []PlutusData{}
+	}
+	return json.Marshal(struct {
+		List []PlutusData `json:"list"`
+	}{list})
+}
+
+func (i PlutusInteger) MarshalJSON() ([]byte, error) {
+	v := i.Value
+	if v == nil {
+		v = new(big.Int)
+	}
+	return []byte(`{"int":` + v.String() + `}`), nil
+}
+
+func (b PlutusBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Bytes string `json:"bytes"`
+	}{hex.EncodeToString(b)})
+}
+
+// UnmarshalPlutusData stores d in the value pointed to by v.
+//
+// Structs are decoded from constructors, their exported fields taking the
+// constructor fields in order. The "plutus" struct tag customizes a field:
+//
+//	Field int      `plutus:"2"`           // takes constructor field 2
+//	Field uint64   `plutus:"constructor"` // takes the constructor index
+//	Field string   `plutus:"0,hex"`       // bytes as a hex string
+//	Field *Credit  `plutus:",maybe"`      // Just x / Nothing
+//	Field int      `plutus:"-"`           // ignored
+//
+// Other Go types are decoded as follows: integers and *big.Int from
+// integers, []byte from bytes, string from bytes as UTF-8 text, bool from
+// constructors 0 (False) and 1 (True), slices from lists, maps from maps,
+// and PlutusData or empty interfaces receive the raw value.
+func UnmarshalPlutusData(d PlutusData, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errors.New("plutus data: UnmarshalPlutusData requires a non-nil pointer")
+	}
+	return decodePlutus(d, rv.Elem(), plutusTag{}, "")
+}
+
+// plutusTag holds the options of a "plutus" struct tag.
+type plutusTag struct {
+	index       int
+	hasIndex    bool
+	skip        bool
+	constructor bool
+	hex         bool
+	maybe       bool
+}
+
+func parsePlutusTag(tag string) (t plutusTag, err error) {
+	if tag == "-" {
+		return plutusTag{skip: true}, nil
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	switch name {
+	case "":
+	case "constructor":
+		t.constructor = true
+	default:
+		if t.index, err = strconv.Atoi(name); err != nil || t.index < 0 {
+			return t, fmt.Errorf("plutus data: invalid struct tag %q", tag)
+		}
+		t.hasIndex = true
+	}
+
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		switch opt {
+		case "hex":
+			t.hex = true
+		case "maybe":
+			t.maybe = true
+		default:
+			return t, fmt.Errorf("plutus data: unknown struct tag option %q", opt)
+		}
+	}
+	return t, nil
+}
+
+var (
+	plutusDataType = reflect.TypeOf((*PlutusData)(nil)).Elem()
+	bigIntType     = reflect.TypeOf(big.Int{})
+)
+
+func decodePlutus(d PlutusData, v reflect.Value, tag plutusTag, path string) error {
+	if path == "" {
+		path = "value"
+	}
+	if d == nil {
+		return fmt.Errorf("plutus data: missing value for %s", path)
+	}
+	mismatch := func() error {
+		return fmt.Errorf("plutus data: cannot decode %T into %s of type %s", d, path, v.Type())
+	}
+
+	if tag.maybe {
+		c, ok := d.(PlutusConstr)
+		if !ok || v.Kind() != reflect.Pointer {
+			return mismatch()
+		}
+		switch {
+		case c.Constructor == 0 && len(c.Fields) == 1:
+			v.Set(reflect.New(v.Type().Elem()))
+			return decodePlutus(c.Fields[0], v.Elem(), plutusTag{hex: tag.hex}, path)
+		case c.Constructor == 1 && len(c.Fields) == 0:
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		return mismatch()
+	}
+
+	if v.Kind() == reflect.Interface && (v.NumMethod() == 0 || v.Type() == plutusDataType) {
+		v.Set(reflect.ValueOf(d))
+		return nil
+	}
+	if dv := reflect.ValueOf(d); dv.Type().AssignableTo(v.Type()) {
+		v.Set(dv)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.Type().Elem() == bigIntType {
+			i, ok := d.(PlutusInteger)
+			if !ok {
+				return mismatch()
+			}
+			v.Set(reflect.ValueOf(new(big.Int).Set(i.big())))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodePlutus(d, v.Elem(), tag, path)
+
+	case reflect.Struct:
+		if v.Type() == bigIntType {
+			i, ok := d.(PlutusInteger)
+			if !ok {
+				return mismatch()
+			}
+			v.Set(reflect.ValueOf(new(big.Int).Set(i.big())).Elem())
+			return nil
+		}
+		c, ok := d.(PlutusConstr)
+		if !ok {
+			return mismatch()
+		}
+		return decodePlutusStruct(c, v, path)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := d.(PlutusInteger)
+		if !ok {
+			return mismatch()
+		}
+		b := i.big()
+		if !b.IsInt64() || v.OverflowInt(b.Int64()) {
+			return fmt.Errorf("plutus data: integer %s overflows %s of type %s", b, path, v.Type())
+		}
+		v.SetInt(b.Int64())
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, ok := d.(PlutusInteger)
+		if !ok {
+			return mismatch()
+		}
+		b := i.big()
+		if !b.IsUint64() || v.OverflowUint(b.Uint64()) {
+			return fmt.Errorf("plutus data: integer %s overflows %s of type %s", b, path, v.Type())
+		}
+		v.SetUint(b.Uint64())
+		return nil
+
+	case reflect.String:
+		b, ok := d.(PlutusBytes)
+		if !ok {
+			return mismatch()
+		}
+		if tag.hex {
+			v.SetString(hex.EncodeToString(b))
+		} else {
+			v.SetString(string(b))
+		}
+		return nil
+
+	case reflect.Bool:
+		c, ok := d.(PlutusConstr)
+		if !ok || c.Constructor > 1 || len(c.Fields) != 0 {
+			return mismatch()
+		}
+		v.SetBool(c.Constructor == 1)
+		return nil
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b, ok := d.(PlutusBytes)
+			if !ok {
+				return mismatch()
+			}
+			v.SetBytes(append([]byte(nil), b...))
+			return nil
+		}
+		var items []PlutusData
+		switch l := d.(type) {
+		case PlutusList:
+			items = l
+		case PlutusConstr:
+			// Tuples and records without a matching struct.
+			items = l.Fields
+		default:
+			return mismatch()
+		}
+		s := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodePlutus(item, s.Index(i), plutusTag{hex: tag.hex}, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+
+	case reflect.Map:
+		m, ok := d.(PlutusMap)
+		if !ok {
+			return mismatch()
+		}
+		out := reflect.MakeMapWithSize(v.Type(), len(m))
+		for i, p := range m {
+			k := reflect.New(v.Type().Key()).Elem()
+			if err := decodePlutus(p.Key, k, plutusTag{hex: tag.hex}, fmt.Sprintf("%s key %d", path, i)); err != nil {
+				return err
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := decodePlutus(p.Value, e, plutusTag{hex: tag.hex}, fmt.Sprintf("%s[%v]", path, k)); err != nil {
+				return err
+			}
+			out.SetMapIndex(k, e)
+		}
+		v.Set(out)
+		return nil
+	}
+
+	return mismatch()
+}
+
+func decodePlutusStruct(c PlutusConstr, v reflect.Value, path string) error {
+	t := v.Type()
+	next := 0
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, err := parsePlutusTag(f.Tag.Get("plutus"))
+		if err != nil {
+			return err
+		}
+		if tag.skip {
+			continue
+		}
+		if tag.constructor {
+			fv := v.Field(i)
+			switch fv.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				fv.SetInt(int64(c.Constructor))
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				fv.SetUint(c.Constructor)
+			default:
+				return fmt.Errorf("plutus data: constructor field %s.%s must be an integer", t, f.Name)
+			}
+			continue
+		}
+
+		index := next
+		if tag.hasIndex {
+			index = tag.index
+		}
+		next = index + 1
+
+		if index >= len(c.Fields) {
+			return fmt.Errorf("plutus data: constructor %d has no field %d for %s.%s", c.Constructor, index, path, f.Name)
+		}
+		if err := decodePlutus(c.Fields[index], v.Field(i), tag, path+"."+f.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i PlutusInteger) big() *big.Int {
+	if i.Value == nil {
+		return new(big.Int)
+	}
+	return i.Value
+}
+
+// DecodeInto decodes the datum value into v, see UnmarshalPlutusData. When
+// Value is nil the datum is decoded from ValueRaw.
+func (d InlineDatum) DecodeInto(v interface{}) error {
+	value := d.Value
+	if value == nil && d.ValueRaw != "" {
+		var err error
+		if value, err = d.DecodeRaw(); err != nil {
+			return err
+		}
+	}
+	if value == nil {
+		if d.valueErr != nil {
+			return d.valueErr
+		}
+		return errors.New("plutus data: inline datum has no value")
+	}
+	return UnmarshalPlutusData(value, v)
+}
+
+// ValueErr returns the error that prevented decoding the JSON value of the
+// datum, nil when Value was decoded or is absent. A datum the client does not
+// understand does not fail the decoding of the UTxO holding it.
+func (d InlineDatum) ValueErr() error {
+	return d.valueErr
+}
+
+func (d *InlineDatum) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	var raw struct {
+		Hash     string          `json:"hash"`
+		Value    json.RawMessage `json:"value"`
+		ValueRaw string          `json:"value_raw"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*d = InlineDatum{Hash: raw.Hash, ValueRaw: raw.ValueRaw}
+	if len(raw.Value) == 0 || bytes.Equal(bytes.TrimSpace(raw.Value), []byte("null")) {
+		return nil
+	}
+
+	d.Value, d.valueErr = ParsePlutusDataJSON(raw.Value)
+	return nil
+}
@@ -0,0 +1,234 @@
+package tangocrypto_go
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestInlineDatumUnknownValue(t *testing.T) {
+	const body = `{"data":[
+		{"hash":"a","inline_datum":{"hash":"h","value":{"constructor":0,"fields":[{"int":42}]},"value_raw":"d8799f182aff"}},
+		{"hash":"b","inline_datum":{"hash":"h","value":{},"value_raw":"d8799f182aff"}},
+		{"hash":"c","inline_datum":{"hash":"h","value":"int","value_raw":""}},
+		{"hash":"d","inline_datum":{"hash":"h","value":{"prim":"unit"}}},
+		{"hash":"e","inline_datum":null}
+	],"cursor":null}`
+
+	var page Page[Data]
+	if err := json.Unmarshal([]byte(body), &page); err != nil {
+		t.Fatalf("one unusual datum failed the whole page: %v", err)
+	}
+	if len(page.Data) != 5 {
+		t.Fatalf("got %d UTxOs, want 5", len(page.Data))
+	}
+
+	type datum struct {
+		N int
+	}
+
+	ok := page.Data[0].InlineDatum
+	if ok.Value == nil || ok.ValueErr() != nil {
+		t.Fatalf("valid datum: Value = %v, ValueErr = %v", ok.Value, ok.ValueErr())
+	}
+
+	// The JSON value is not understood but the raw CBOR is.
+	fallback := page.Data[1].InlineDatum
+	if fallback.Value != nil || fallback.ValueErr() == nil {
+		t.Fatalf("unknown datum: Value = %v, ValueErr = %v", fallback.Value, fallback.ValueErr())
+	}
+	var d datum
+	if err := fallback.DecodeInto(&d); err != nil || d.N != 42 {
+		t.Fatalf("DecodeInto from raw = %+v, %v", d, err)
+	}
+
+	for _, utxo := range page.Data[2:4] {
+		if utxo.InlineDatum.ValueErr() == nil {
+			t.Errorf("UTxO %s: ValueErr is nil", utxo.Hash)
+		}
+		if err := utxo.InlineDatum.DecodeInto(&d); err == nil {
+			t.Errorf("UTxO %s: DecodeInto succeeded without a value", utxo.Hash)
+		}
+	}
+
+	if none := page.Data[4].InlineDatum; none.Value != nil || none.ValueErr() != nil {
+		t.Errorf("null datum: Value = %v, ValueErr = %v", none.Value, none.ValueErr())
+	}
+}
+
+type testCredential struct {
+	Kind uint64 `plutus:"constructor"`
+	Hash string `plutus:"0,hex"`
+}
+
+type testAddress struct {
+	Payment testCredential
+	Stake   *testCredential `plutus:",maybe"`
+}
+
+type testAsset struct {
+	Policy string `plutus:",hex"`
+	Name   []byte
+}
+
+// testOrder is a DEX swap order datum.
+type testOrder struct {
+	Kind       uint8 `plutus:"constructor"`
+	Owner      testAddress
+	Offer      testAsset
+	Ask        testAsset
+	Amount     *big.Int
+	MinReceive big.Int
+	Deadline   *int64 `plutus:",maybe"`
+	Partial    bool
+	Ignored    string `plutus:"-"`
+	Fee        uint16 `plutus:"8"`
+	Batchers   map[string]int64
+	Tags       []string
+	Raw        PlutusData
+	Any        interface{}
+
+	internal int
+}
+
+func TestUnmarshalPlutusData(t *testing.T) {
+	hash := bytes.Repeat([]byte{0xab}, 28)
+	policy := bytes.Repeat([]byte{0x01}, 28)
+	constr := func(n uint64, fields ...PlutusData) PlutusConstr {
+		return PlutusConstr{Constructor: n, Fields: fields}
+	}
+	amount, _ := new(big.Int).SetString("18446744073709551616", 10)
+
+	datum := constr(1,
+		constr(0, constr(0, PlutusBytes(hash)), constr(1)),
+		constr(0, PlutusBytes(policy), text("MIN")),
+		constr(0, PlutusBytes{}, PlutusBytes{}),
+		PlutusInteger{Value: amount},
+		integer(-3),
+		constr(0, integer(1700000000000)),
+		constr(1),
+		integer(99), // skipped by the index jump
+		integer(65535),
+		PlutusMap{{Key: text("b1"), Value: integer(1)}, {Key: text("b2"), Value: integer(2)}},
+		PlutusList{text("limit"), text("gtc")},
+		PlutusList{integer(7)},
+		text("any"),
+	)
+
+	var got testOrder
+	got.Ignored = "kept"
+	if err := UnmarshalPlutusData(datum, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := int64(1700000000000)
+	want := testOrder{
+		Kind:       1,
+		Owner:      testAddress{Payment: testCredential{Hash: hex.EncodeToString(hash)}},
+		Offer:      testAsset{Policy: hex.EncodeToString(policy), Name: []byte("MIN")},
+		Ask:        testAsset{Policy: "", Name: []byte{}},
+		Amount:     amount,
+		MinReceive: *big.NewInt(-3),
+		Deadline:   &deadline,
+		Partial:    true,
+		Ignored:    "kept",
+		Fee:        65535,
+		Batchers:   map[string]int64{"b1": 1, "b2": 2},
+		Tags:       []string{"limit", "gtc"},
+		Raw:        PlutusList{integer(7)},
+		Any:        text("any"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("UnmarshalPlutusData =\n%+v\nwant\n%+v", got, want)
+	}
+	if got.Amount == amount {
+		t.Error("Amount aliases the datum integer")
+	}
+
+	// Nothing clears a Maybe field that was set, Just fills a nil one.
+	got.Deadline = &deadline
+	datum.Fields[5] = constr(1)
+	datum.Fields[0] = constr(0, constr(1, PlutusBytes(hash)), constr(0, constr(1, PlutusBytes(hash))))
+	if err := UnmarshalPlutusData(datum, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Deadline != nil {
+		t.Errorf("Deadline = %d, want nil", *got.Deadline)
+	}
+	if got.Owner.Payment.Kind != 1 || got.Owner.Stake == nil || got.Owner.Stake.Kind != 1 || got.Owner.Stake.Hash != hex.EncodeToString(hash) {
+		t.Errorf("Owner = %+v", got.Owner)
+	}
+}
+
+func TestUnmarshalPlutusDataErrors(t *testing.T) {
+	var (
+		i     int
+		u8    uint8
+		i8    int8
+		s     string
+		b     bool
+		bi    big.Int
+		list  []int
+		m     map[string]int
+		maybe struct {
+			V int `plutus:",maybe"`
+		}
+		ptrMaybe struct {
+			V *int `plutus:",maybe"`
+		}
+		missing struct {
+			A int
+			B int `plutus:"3"`
+		}
+		badTag struct {
+			A int `plutus:"x"`
+		}
+		badOption struct {
+			A int `plutus:"0,omitempty"`
+		}
+		badConstructor struct {
+			C string `plutus:"constructor"`
+		}
+	)
+	big65 := PlutusInteger{Value: new(big.Int).Lsh(big.NewInt(1), 64)}
+
+	tests := []struct {
+		name string
+		d    PlutusData
+		v    interface{}
+	}{
+		{"non-pointer", integer(1), i},
+		{"nil pointer", integer(1), (*int)(nil)},
+		{"nil value", nil, &i},
+		{"int from bytes", text("1"), &i},
+		{"int overflow", big65, &i},
+		{"int8 overflow", integer(128), &i8},
+		{"uint8 overflow", integer(256), &u8},
+		{"uint negative", integer(-1), &u8},
+		{"string from int", integer(1), &s},
+		{"bool constructor 2", PlutusConstr{Constructor: 2}, &b},
+		{"bool with fields", PlutusConstr{Constructor: 1, Fields: []PlutusData{integer(1)}}, &b},
+		{"big.Int from bytes", text("1"), &bi},
+		{"slice from map", PlutusMap{}, &list},
+		{"slice item mismatch", PlutusList{text("x")}, &list},
+		{"map from list", PlutusList{}, &m},
+		{"map key mismatch", PlutusMap{{Key: integer(1), Value: integer(1)}}, &m},
+		{"struct from list", PlutusList{}, &missing},
+		{"missing field", PlutusConstr{Fields: []PlutusData{integer(1)}}, &missing},
+		{"maybe on non-pointer", PlutusConstr{Fields: []PlutusData{PlutusConstr{Fields: []PlutusData{integer(1)}}}}, &maybe},
+		{"maybe constructor 2", PlutusConstr{Fields: []PlutusData{PlutusConstr{Constructor: 2}}}, &ptrMaybe},
+		{"nothing with fields", PlutusConstr{Fields: []PlutusData{PlutusConstr{Constructor: 1, Fields: []PlutusData{integer(1)}}}}, &ptrMaybe},
+		{"maybe of bytes", PlutusConstr{Fields: []PlutusData{text("x")}}, &ptrMaybe},
+		{"invalid index tag", PlutusConstr{Fields: []PlutusData{integer(1)}}, &badTag},
+		{"unknown tag option", PlutusConstr{Fields: []PlutusData{integer(1)}}, &badOption},
+		{"constructor into string", PlutusConstr{}, &badConstructor},
+	}
+	for _, tt := range tests {
+		if err := UnmarshalPlutusData(tt.d, tt.v); err == nil {
+			t.Errorf("%s: UnmarshalPlutusData succeeded", tt.name)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package tangocrypto_go
+
+import (
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the client-side token bucket shared by every request
+// made through an APIClient, including retries.
+type RateLimit struct {
+	// Rate is the number of tokens added to the bucket per second, usually
+	// the requests-per-second quota of the app.
+	Rate float64
+
+	// Burst is the capacity of the bucket. Defaults to Rate rounded up.
+	Burst int
+
+	// Weights sets the number of tokens consumed by requests to an endpoint.
+	// Keys are paths relative to the API version, such as "addresses" or
+	// "transactions/submit"; the longest matching prefix wins. Requests
+	// weigh 1 token by default.
+	Weights map[string]int
+}
+
+// limiter is a token bucket. Tokens can go negative so that concurrent
+// waiters are served in the order they arrived.
+type limiter struct {
+	rate    float64
+	burst   float64
+	weights map[string]int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(cfg *RateLimit) *limiter {
+	if cfg == nil || cfg.Rate <= 0 {
+		return nil
+	}
+
+	burst := float64(cfg.Burst)
+	if burst <= 0 {
+		burst = math.Ceil(cfg.Rate)
+	}
+
+	return &limiter{
+		rate:    cfg.Rate,
+		burst:   burst,
+		weights: cfg.Weights,
+		tokens:  burst,
+		last:    time.Now(),
+	}
+}
+
+// wait blocks until the tokens needed by req are available or the context of
+// req is done.
+func (l *limiter) wait(req *http.Request) error {
+	if l == nil {
+		return nil
+	}
+
+	n := math.Min(float64(l.weight(req)), l.burst)
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens -= n
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if err := sleep(req.Context(), delay); err != nil {
+		l.mu.Lock()
+		l.tokens += n
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// weight returns the number of tokens consumed by req.
+func (l *limiter) weight(req *http.Request) int {
+	if len(l.weights) == 0 {
+		return 1
+	}
+
+	_, path, found := strings.Cut(req.URL.Path, "/v1/")
+	if !found {
+		return 1
+	}
+
+	for path != "" {
+		if w, ok := l.weights[path]; ok && w > 0 {
+			return w
+		}
+		i := strings.LastIndexByte(path, '/')
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return 1
+}
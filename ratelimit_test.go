@@ -0,0 +1,151 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func limiterRequest(t *testing.T, ctx context.Context, path string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/app/v1/"+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestLimiterBurst(t *testing.T) {
+	l := newLimiter(&RateLimit{Rate: 10, Burst: 5})
+	req := limiterRequest(t, context.Background(), "blocks/latest")
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.wait(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("burst of 5 took %s, want no wait", elapsed)
+	}
+
+	start = time.Now()
+	if err := l.wait(req); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Fatalf("request after the burst waited %s, want about 100ms", elapsed)
+	}
+
+	if newLimiter(nil) != nil || newLimiter(&RateLimit{}) != nil {
+		t.Error("a limiter without a rate is not disabled")
+	}
+	if l := newLimiter(&RateLimit{Rate: 2.5}); l.burst != 3 {
+		t.Errorf("default burst = %v, want 3", l.burst)
+	}
+}
+
+func TestLimiterSharedAcrossGoroutines(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Write([]byte(`{"no":1}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(APIClientOptions{
+		AppID:     "app",
+		Server:    server.URL,
+		RateLimit: &RateLimit{Rate: 20, Burst: 1},
+	})
+
+	const goroutines, perGoroutine = 4, 3
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				if _, err := client.CurrentEpoch(context.Background()); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 12 requests at 20 per second with a burst of 1: 11 intervals of 50ms.
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond || elapsed > 3*time.Second {
+		t.Errorf("%d requests took %s, want about 550ms", goroutines*perGoroutine, elapsed)
+	}
+	if calls.Load() != goroutines*perGoroutine {
+		t.Errorf("%d requests, want %d", calls.Load(), goroutines*perGoroutine)
+	}
+}
+
+func TestLimiterWeight(t *testing.T) {
+	l := newLimiter(&RateLimit{
+		Rate: 1,
+		Weights: map[string]int{
+			"transactions":        2,
+			"transactions/submit": 5,
+			"blocks":              0,
+		},
+	})
+
+	tests := []struct {
+		path   string
+		weight int
+	}{
+		{"transactions/submit", 5},
+		{"transactions/submit/extra", 5},
+		{"transactions/abcd/utxos", 2},
+		{"transactions", 2},
+		{"transactionsx", 1},
+		{"blocks/latest", 1},
+		{"epochs/current", 1},
+	}
+	for _, tt := range tests {
+		if w := l.weight(limiterRequest(t, context.Background(), tt.path)); w != tt.weight {
+			t.Errorf("weight(%s) = %d, want %d", tt.path, w, tt.weight)
+		}
+	}
+
+	unweighted := newLimiter(&RateLimit{Rate: 1})
+	if w := unweighted.weight(limiterRequest(t, context.Background(), "transactions/submit")); w != 1 {
+		t.Errorf("weight without Weights = %d, want 1", w)
+	}
+}
+
+func TestLimiterCancelReturnsTokens(t *testing.T) {
+	l := newLimiter(&RateLimit{Rate: 1, Burst: 1})
+	if err := l.wait(limiterRequest(t, context.Background(), "blocks/latest")); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := l.wait(limiterRequest(t, ctx, "blocks/latest"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("cancelled wait returned after %s", elapsed)
+	}
+
+	// The cancelled request gave its token back: the bucket is refilling
+	// from empty, not from one token in debt.
+	l.mu.Lock()
+	tokens := l.tokens
+	l.mu.Unlock()
+	if tokens < -0.5 {
+		t.Errorf("tokens = %.2f after cancellation, want about 0", tokens)
+	}
+}
@@ -0,0 +1,180 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of requests failing with a
+// transient error: a network error, 429 (Too Many Requests), 500, 502, 503
+// or 504. Only GET and HEAD requests are retried unless RetrySubmit is set.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// InitialBackoff is the delay before the first retry. Defaults to 500ms.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts. Defaults to 30s. A
+	// response asking through Retry-After to wait longer is not retried: its
+	// error is returned so the caller can wait out the server's window.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor applied to the delay after each retry.
+	// Defaults to 2.
+	Multiplier float64
+
+	// Jitter is the fraction of each delay, between 0 and 1, that is
+	// randomized to avoid synchronized retries.
+	Jitter float64
+
+	// RetrySubmit allows TransactionSubmit to be retried. A transaction that
+	// already reached the node is rejected on resubmission, so callers
+	// enabling it must treat such errors as a possible success.
+	RetrySubmit bool
+}
+
+// DefaultRetryPolicy returns a policy retrying up to 3 times with
+// exponential backoff starting at 500ms.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// shouldRetry reports whether the outcome of attempt can be retried and how
+// long to wait before doing so.
+func (p *RetryPolicy) shouldRetry(req *http.Request, res *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries {
+		return 0, false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return 0, false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+	default:
+		if !p.RetrySubmit {
+			return 0, false
+		}
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			return 0, false
+		}
+		return p.backoff(attempt, 0), true
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		requested := retryAfter(res)
+		if requested > p.maxBackoff() {
+			return 0, false
+		}
+		return p.backoff(attempt, requested), true
+	}
+
+	return 0, false
+}
+
+// backoff returns the delay before retry number attempt+1. The delay is at
+// least the one requested by the server.
+func (p *RetryPolicy) backoff(attempt int, requested time.Duration) time.Duration {
+	initial, max, multiplier := p.InitialBackoff, p.maxBackoff(), p.Multiplier
+	if initial <= 0 {
+		initial = 500 * time.Millisecond
+	}
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(attempt))
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay += delay * jitter * (2*rand.Float64() - 1)
+	}
+
+	d := time.Duration(math.Min(delay, float64(max)))
+	if requested > d {
+		d = requested
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return 30 * time.Second
+	}
+	return p.MaxBackoff
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as
+// an HTTP date.
+func retryAfter(res *http.Response) time.Duration {
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// rewind prepares req to be sent again.
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// discard drains and closes the body of a response that will not be used so
+// that the underlying connection can be reused.
+func discard(res *http.Response) {
+	if res == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	res.Body.Close()
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
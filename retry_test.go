@@ -0,0 +1,164 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// retryServer answers with the statuses in order, then 200. It counts the
+// requests it receives.
+func retryServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(statuses[n-1])
+			w.Write([]byte(`{"message":"try again"}`))
+			return
+		}
+		w.Write([]byte(`{"no":42}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func retryClient(server *httptest.Server, policy *RetryPolicy) APIClient {
+	return NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL, Retry: policy})
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	server, calls := retryServer(t, nil, http.StatusServiceUnavailable, http.StatusBadGateway)
+	client := retryClient(server, &RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond})
+
+	epoch, err := client.CurrentEpoch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if epoch.No != 42 || calls.Load() != 3 {
+		t.Fatalf("epoch %d after %d requests, want 42 after 3", epoch.No, calls.Load())
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	server, calls := retryServer(t, nil, 500, 500, 500, 500)
+	client := retryClient(server, &RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond})
+
+	_, err := client.CurrentEpoch(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 500 || !errors.Is(err, ErrServer) {
+		t.Fatalf("err = %v, want 500 *APIError", err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("%d requests, want 3", calls.Load())
+	}
+}
+
+func TestRetryNotFoundIsNotRetried(t *testing.T) {
+	server, calls := retryServer(t, nil, http.StatusNotFound)
+	client := retryClient(server, DefaultRetryPolicy())
+
+	if _, err := client.CurrentEpoch(context.Background()); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("%d requests, want 1", calls.Load())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	header := http.Header{"Retry-After": {"1"}}
+	server, calls := retryServer(t, header, http.StatusTooManyRequests)
+	client := retryClient(server, &RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Second})
+
+	start := time.Now()
+	if _, err := client.CurrentEpoch(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("retried after %s, want at least the 1s Retry-After", elapsed)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("%d requests, want 2", calls.Load())
+	}
+}
+
+func TestRetryAfterBeyondMaxBackoff(t *testing.T) {
+	header := http.Header{"Retry-After": {"60"}}
+	server, calls := retryServer(t, header, http.StatusTooManyRequests)
+	client := retryClient(server, &RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Second})
+
+	start := time.Now()
+	_, err := client.CurrentEpoch(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests || !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("err = %v, want 429 *APIError", err)
+	}
+	if calls.Load() != 1 || time.Since(start) > 500*time.Millisecond {
+		t.Fatalf("%d requests in %s, want 1 without waiting", calls.Load(), time.Since(start))
+	}
+}
+
+func TestRetrySubmit(t *testing.T) {
+	const tx = "84a30081825820"
+
+	for _, retrySubmit := range []bool{false, true} {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != tx {
+				t.Errorf("request %d body = %q, want %q", calls.Load()+1, body, tx)
+			}
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`"abcd"`))
+		}))
+
+		policy := DefaultRetryPolicy()
+		policy.InitialBackoff = time.Millisecond
+		policy.RetrySubmit = retrySubmit
+		hash, err := retryClient(server, policy).TransactionSubmit(context.Background(), []byte(tx))
+		server.Close()
+
+		if retrySubmit {
+			if err != nil || hash != "abcd" || calls.Load() != 2 {
+				t.Errorf("RetrySubmit: hash %q, err %v after %d requests", hash, err, calls.Load())
+			}
+		} else {
+			if !errors.Is(err, ErrServer) || calls.Load() != 1 {
+				t.Errorf("POST retried: err %v after %d requests", err, calls.Load())
+			}
+		}
+	}
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	server, calls := retryServer(t, nil, 503, 503, 503, 503)
+	client := retryClient(server, &RetryPolicy{MaxRetries: 3, InitialBackoff: 10 * time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.CurrentEpoch(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("returned after %s, the backoff ignored the context", elapsed)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("%d requests, want 1", calls.Load())
+	}
+}
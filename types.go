@@ -1,58 +1,78 @@
 package tangocrypto_go
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 const (
 	CardanoMainNet = "https://cardano-mainnet.tangocrypto.com"
 	CardanoTestNet = "https://cardano-testnet.tangocrypto.com"
 )
 
-// APIError is used to describe errors from the API.
+// Sentinel errors matched by *APIError through errors.Is, e.g.
+//
+//	if errors.Is(err, ErrNotFound) {
+//		...
+//	}
+var (
+	ErrBadRequest   = errors.New("tangocrypto: bad request")
+	ErrUnauthorized = errors.New("tangocrypto: unauthorized")
+	ErrForbidden    = errors.New("tangocrypto: forbidden")
+	ErrNotFound     = errors.New("tangocrypto: not found")
+	ErrRateLimited  = errors.New("tangocrypto: rate limited")
+	ErrServer       = errors.New("tangocrypto: server error")
+)
+
+// APIError is used to describe errors from the API. Use errors.As to access
+// its fields and errors.Is to compare it with the sentinel errors.
 type APIError struct {
-	Response interface{}
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is the error name reported by the API, e.g. "Not Found".
+	Code string
+	// Message is the human readable description of the error.
+	Message string
+	// RequestID identifies the request on the API side, when provided.
+	RequestID string
+	// Method and Endpoint describe the request that failed.
+	Method   string
+	Endpoint string
+	// Body is the raw response body.
+	Body []byte
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("API Error, %+v", e.Response)
-}
-
-// BadRequest defines model for HTTP `400` (Bad Request)
-type BadRequest struct {
-	Error      string `json:"error"`
-	Message    string `json:"message"`
-	StatusCode int    `json:"status_code"`
-}
-
-// UnauthorizedError UnauthorizedEror defines model for HTTP `401` (Unauthorized).
-type UnauthorizedError struct {
-	Error      string `json:"error"`
-	Message    string `json:"message"`
-	StatusCode int    `json:"status_code"`
+	msg := e.Message
+	if msg == "" {
+		msg = e.Code
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("tangocrypto: %s %s: %d: %s", e.Method, e.Endpoint, e.StatusCode, msg)
 }
 
-// ForbiddenError defines model for HTTP `403` (Forbidden).
-type ForbiddenError struct {
-	Error      string `json:"error"`
-	Message    string `json:"message"`
-	StatusCode int    `json:"status_code"`
-}
-
-// NotFound defines model for HTTP `404` (Resource Not Found).
-type NotFound struct {
-	Error      string `json:"error"`
-	Message    string `json:"message"`
-	StatusCode int    `json:"status_code"`
-}
-
-// TooManyRequestsError defines model for HTTP `429` (Over Usage).
-type TooManyRequestsError struct {
-	Error      string `json:"error"`
-	Message    string `json:"message"`
-	StatusCode int    `json:"status_code"`
+// Is reports whether target is the sentinel error matching the status code.
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return e.StatusCode >= 500 && target == ErrServer
 }
 
-// InternalServerError defines model for HTTP `500` (Internal Server Error)
-type InternalServerError struct {
+// errorResponse defines the model of API error bodies.
+type errorResponse struct {
 	Error      string `json:"error"`
 	Message    string `json:"message"`
 	StatusCode int    `json:"status_code"`
@@ -1,85 +1,140 @@
 package tangocrypto_go
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
+// requestIDHeaders lists the headers that may carry the API request ID.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "Apigw-Requestid"}
+
+// maxErrorBody is the number of bytes of an error response kept in APIError.
+const maxErrorBody = 4096
+
+// handleAPIErrorResponse converts a non-2xx response into an *APIError. It
+// never fails: bodies that are not JSON, such as gateway HTML pages, are
+// kept as the error message.
 func handleAPIErrorResponse(res *http.Response) error {
-	var err error
-	switch res.StatusCode {
-	case 400:
-		br := BadRequest{}
-		if err = json.NewDecoder(res.Body).Decode(&br); err != nil {
-			return err
-		}
-		return &APIError{
-			Response: br,
-		}
-	case 401:
-		ue := UnauthorizedError{}
-		if err = json.NewDecoder(res.Body).Decode(&ue); err != nil {
-			return err
-		}
-		return &APIError{
-			Response: ue,
-		}
-	case 403:
-		fe := ForbiddenError{}
-		if err = json.NewDecoder(res.Body).Decode(&fe); err != nil {
-			return err
-		}
-		return &APIError{
-			Response: fe,
-		}
-	case 404:
-		nf := NotFound{}
-		if err = json.NewDecoder(res.Body).Decode(&nf); err != nil {
-			return err
-		}
-		return &APIError{
-			Response: nf,
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+	}
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.Endpoint = res.Request.URL.Path
+	}
+	for _, h := range requestIDHeaders {
+		if id := res.Header.Get(h); id != "" {
+			apiErr.RequestID = id
+			break
 		}
-	case 429:
-		tmre := TooManyRequestsError{}
-		if err = json.NewDecoder(res.Body).Decode(&tmre); err != nil {
-			return err
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+	apiErr.Body = body
+	if err != nil {
+		apiErr.Message = fmt.Sprintf("reading response body: %v", err)
+		return apiErr
+	}
+
+	er := errorResponse{}
+	if json.Unmarshal(body, &er) == nil && (er.Error != "" || er.Message != "") {
+		apiErr.Code = er.Error
+		apiErr.Message = er.Message
+		return apiErr
+	}
+
+	apiErr.Message = strings.TrimSpace(string(body))
+	return apiErr
+}
+
+// isSuccess reports whether the status code denotes a successful response.
+func isSuccess(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 300
+}
+
+func (c *apiClient) handleRequest(req *http.Request) (res *http.Response, err error) {
+	req.Header.Add("x-api-key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	for attempt := 0; ; attempt++ {
+		if err = c.limiter.wait(req); err != nil {
+			return nil, err
 		}
-		return &APIError{
-			Response: tmre,
+
+		res, err = c.client.Do(req)
+		if err == nil && isSuccess(res.StatusCode) {
+			return res, nil
 		}
-	case 500:
-		ise := InternalServerError{}
-		if err = json.NewDecoder(res.Body).Decode(&ise); err != nil {
-			return err
+
+		delay, retry := c.retry.shouldRetry(req, res, err, attempt)
+		if !retry {
+			break
 		}
-		return &APIError{
-			Response: ise,
+		if err == nil {
+			discard(res)
 		}
-	default:
-		data, err := ioutil.ReadAll(res.Body)
-		if err != nil {
-			return err
+
+		if err = sleep(req.Context(), delay); err != nil {
+			return nil, err
 		}
-		return &APIError{
-			Response: string(data),
+		if err = rewind(req); err != nil {
+			return nil, err
 		}
 	}
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+
+	return res, handleAPIErrorResponse(res)
 }
 
-func (c *apiClient) handleRequest(req *http.Request) (res *http.Response, err error) {
-	req.Header.Add("x-api-key", c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
+// endpoint builds the URL of an API resource from its path segments.
+func (c *apiClient) endpoint(query url.Values, path ...string) (*url.URL, error) {
+	segments := make([]string, len(path))
+	for i, p := range path {
+		segments[i] = url.PathEscape(p)
+	}
 
-	res, err = c.client.Do(req)
+	requestURL, err := url.Parse(fmt.Sprintf("%s/%s/v1/%s", c.server, c.appID, strings.Join(segments, "/")))
 	if err != nil {
-		return
+		return nil, err
+	}
+	if len(query) > 0 {
+		requestURL.RawQuery = query.Encode()
+	}
+
+	return requestURL, nil
+}
+
+// getJSON performs a GET request against the resource at path and decodes
+// the JSON response into v.
+func (c *apiClient) getJSON(ctx context.Context, query url.Values, v interface{}, path ...string) error {
+	requestURL, err := c.endpoint(query, path...)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.handleRequest(req)
+	if err != nil {
+		return err
 	}
+	defer resp.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return res, handleAPIErrorResponse(res)
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
 	}
 
-	return res, nil
+	return json.NewDecoder(resp.Body).Decode(v)
 }
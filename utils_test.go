@@ -0,0 +1,135 @@
+package tangocrypto_go
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// errorServer answers every request with status, header and body.
+func errorServer(t *testing.T, status int, header http.Header, body string) APIClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return NewAPIClient(APIClientOptions{AppID: "app", Server: server.URL})
+}
+
+func TestAPIErrorHTMLBody(t *testing.T) {
+	const page = "<html><body><h1>502 Bad Gateway</h1></body></html>"
+	client := errorServer(t, http.StatusBadGateway, http.Header{"Content-Type": {"text/html"}}, page+"\n")
+
+	_, err := client.CurrentEpoch(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != 502 || apiErr.Message != page || apiErr.Code != "" {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+	if apiErr.Method != http.MethodGet || apiErr.Endpoint != "/app/v1/epochs/current" {
+		t.Errorf("request = %s %s", apiErr.Method, apiErr.Endpoint)
+	}
+	if !errors.Is(err, ErrServer) || errors.Is(err, ErrNotFound) {
+		t.Errorf("errors.Is mapping of %v", err)
+	}
+	if !strings.Contains(err.Error(), "502 Bad Gateway") {
+		t.Errorf("Error() = %s", err)
+	}
+}
+
+func TestAPIErrorTruncatedBody(t *testing.T) {
+	body := strings.Repeat("x", 3*maxErrorBody)
+	client := errorServer(t, http.StatusInternalServerError, nil, body)
+
+	_, err := client.CurrentEpoch(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if len(apiErr.Body) != maxErrorBody || len(apiErr.Message) != maxErrorBody {
+		t.Errorf("kept %d bytes of body, %d of message, want %d", len(apiErr.Body), len(apiErr.Message), maxErrorBody)
+	}
+}
+
+func TestAPIErrorJSONBody(t *testing.T) {
+	header := http.Header{"X-Amzn-Requestid": {"req-123"}}
+	body := `{"error":"Not Found","message":"epoch 9999 not found","status_code":404}`
+	client := errorServer(t, http.StatusNotFound, header, body)
+
+	_, err := client.Epoch(context.Background(), 9999)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want *APIError", err)
+	}
+	if apiErr.Code != "Not Found" || apiErr.Message != "epoch 9999 not found" || apiErr.RequestID != "req-123" {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+	if string(apiErr.Body) != body {
+		t.Errorf("Body = %s", apiErr.Body)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("%v is not ErrNotFound", err)
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	sentinels := []error{ErrBadRequest, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited, ErrServer}
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{400, ErrBadRequest},
+		{401, ErrUnauthorized},
+		{403, ErrForbidden},
+		{404, ErrNotFound},
+		{429, ErrRateLimited},
+		{500, ErrServer},
+		{503, ErrServer},
+		{599, ErrServer},
+		{409, nil},
+		{418, nil},
+	}
+	for _, tt := range tests {
+		err := error(&APIError{StatusCode: tt.status})
+		for _, s := range sentinels {
+			if got := errors.Is(err, s); got != (s == tt.want) {
+				t.Errorf("errors.Is(%d, %v) = %v", tt.status, s, got)
+			}
+		}
+	}
+}
+
+func TestTransactionSubmitStatuses(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		hash   string
+	}{
+		{http.StatusOK, `"abcd"`, "abcd"},
+		{http.StatusAccepted, `"abcd"`, "abcd"},
+		{http.StatusAccepted, `{"tx_id":"abcd"}`, "abcd"},
+		{http.StatusAccepted, "", ""},
+		{http.StatusNoContent, "", ""},
+	}
+	for _, tt := range tests {
+		client := errorServer(t, tt.status, nil, tt.body)
+		hash, err := client.TransactionSubmit(context.Background(), []byte{0x84})
+		if err != nil || hash != tt.hash {
+			t.Errorf("%d %q: hash %q, err %v, want %q", tt.status, tt.body, hash, err, tt.hash)
+		}
+	}
+
+	client := errorServer(t, http.StatusBadRequest, nil, `{"error":"Bad Request","message":"invalid transaction"}`)
+	if _, err := client.TransactionSubmit(context.Background(), []byte{0x84}); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("err = %v, want ErrBadRequest", err)
+	}
+}